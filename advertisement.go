@@ -0,0 +1,74 @@
+package depute
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ipld/go-ipld-prime"
+	depute "github.com/ipni/depute/api/v0"
+	"github.com/ipni/go-libipni/ingest/schema"
+)
+
+// loadAdvertisement loads the advertisement with the given link from the link
+// system.
+func (d *Depute) loadAdvertisement(ctx context.Context, l ipld.Link) (*schema.Advertisement, error) {
+	n, err := d.ls.Load(ipld.LinkContext{Ctx: ctx}, l, schema.AdvertisementPrototype)
+	if err != nil {
+		return nil, err
+	}
+	return schema.UnwrapAdvertisement(n)
+}
+
+// walkAdvertisements calls f for each advertisement in the chain, starting at
+// the given link and following previous links until the start of the chain is
+// reached, or f returns false or an error.
+func (d *Depute) walkAdvertisements(ctx context.Context, from ipld.Link, f func(ipld.Link, *schema.Advertisement) (bool, error)) error {
+	for l := from; l != nil; {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		ad, err := d.loadAdvertisement(ctx, l)
+		if err != nil {
+			return fmt.Errorf("cannot load advertisement %s: %w", l, err)
+		}
+		if more, err := f(l, ad); err != nil || !more {
+			return err
+		}
+		l = ad.PreviousID
+	}
+	return nil
+}
+
+// toAdvertisement converts the advertisement with the given link to its
+// protobuf representation. The entries link is left unset if the
+// advertisement has no entries.
+func toAdvertisement(l ipld.Link, ad *schema.Advertisement) (*depute.Advertisement, error) {
+	var id depute.Link
+	if err := id.Marshal(l); err != nil {
+		return nil, err
+	}
+	pad := &depute.Advertisement{
+		ID:        &id,
+		ContextId: ad.ContextID,
+		Metadata:  ad.Metadata,
+		Removed:   ad.IsRm,
+		Provider:  ad.Provider,
+		Addresses: ad.Addresses,
+		Signature: ad.Signature,
+	}
+	if ad.Entries != nil && ad.Entries != schema.NoEntries {
+		var entries depute.Link
+		if err := entries.Marshal(ad.Entries); err != nil {
+			return nil, err
+		}
+		pad.Entries = &entries
+	}
+	if ad.PreviousID != nil {
+		var previous depute.Link
+		if err := previous.Marshal(ad.PreviousID); err != nil {
+			return nil, err
+		}
+		pad.Previous = &previous
+	}
+	return pad, nil
+}
@@ -0,0 +1,272 @@
+package depute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	depute "github.com/ipni/depute/api/v0"
+	"github.com/ipni/go-libipni/announce"
+	"github.com/ipni/go-libipni/announce/message"
+)
+
+// announcer delivers announcements through a single sender and records the
+// outcome of the latest delivery. Failed deliveries are retried in the
+// background if retry is set.
+type announcer struct {
+	// name identifies the sender, i.e. pubsub or the indexer URL.
+	name   string
+	sender announce.Sender
+	retry  bool
+
+	mu sync.Mutex
+	// seq is the sequence number of the newest head delivered or pending.
+	// Deliveries of older heads are dropped, so that a delivery that
+	// completes late does not supersede that of a newer head.
+	seq         uint64
+	cid         cid.Cid
+	lastAttempt time.Time
+	lastSuccess time.Time
+	lastErr     error
+	// failures is the number of consecutive failed deliveries.
+	failures uint64
+	// pending is the message to retry, if any, which is the latest message
+	// that failed to be delivered.
+	pending   *message.Message
+	retrying  bool
+	nextRetry time.Time
+}
+
+func (d *Depute) AnnounceStatus(_ context.Context, _ *depute.AnnounceStatus_Request) (*depute.AnnounceStatus_Response, error) {
+	var resp depute.AnnounceStatus_Response
+	for _, a := range d.announcers {
+		resp.Senders = append(resp.Senders, a.status())
+	}
+	return &resp, nil
+}
+
+// announce announces the given advertisement, which is the chain head with
+// the given sequence number, through all the announcers, and returns the
+// failed deliveries. Failed HTTP deliveries are retried in the background.
+func (d *Depute) announce(ctx context.Context, c cid.Cid, seq uint64) error {
+	msg := message.Message{
+		Cid: c,
+	}
+	msg.SetAddrs(d.publishAddrs)
+	var errs []error
+	for _, a := range d.announcers {
+		if err := d.deliver(ctx, a, msg, seq); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", a.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// announceHead announces the given advertisement as the chain head with the
+// given sequence number, or queues an announcement of the chain head once the
+// announce window elapses if set. The head announced then is the newest one,
+// whether or not its publication skipped announcement. Failed deliveries are
+// logged, and retried where possible.
+func (d *Depute) announceHead(ctx context.Context, c cid.Cid, seq uint64) {
+	if d.announceWindow == 0 {
+		_ = d.announce(ctx, c, seq)
+		return
+	}
+	d.announceLock.Lock()
+	d.pendingAnnounce = true
+	d.announceLock.Unlock()
+	select {
+	case d.announceQueued <- struct{}{}:
+	default:
+	}
+}
+
+// announceLoop announces the chain head at the end of each announce window,
+// which starts as an announcement is queued, until Shutdown. An announcement
+// still queued on Shutdown is made.
+func (d *Depute) announceLoop() {
+	defer d.wg.Done()
+	for {
+		select {
+		case <-d.closing:
+			d.flushAnnounce(context.Background())
+			return
+		case <-d.announceQueued:
+		}
+		timer := time.NewTimer(d.announceWindow)
+		select {
+		case <-d.closing:
+			timer.Stop()
+			d.flushAnnounce(context.Background())
+			return
+		case <-timer.C:
+		}
+		ctx, cancel := d.closingContext()
+		d.flushAnnounce(ctx)
+		cancel()
+	}
+}
+
+// flushAnnounce announces the current chain head if an announcement is
+// queued.
+func (d *Depute) flushAnnounce(ctx context.Context) {
+	d.announceLock.Lock()
+	pending := d.pendingAnnounce
+	d.pendingAnnounce = false
+	d.announceLock.Unlock()
+	if !pending {
+		return
+	}
+	head, seq, err := d.currentHead(ctx)
+	if err != nil {
+		logger.Errorw("Failed to get latest ad link to announce", "err", err)
+		return
+	}
+	_ = d.announce(ctx, linkCid(head), seq)
+}
+
+// deliver sends the given message, which announces the chain head with the
+// given sequence number, through the announcer, scheduling a retry if it
+// fails. The message is dropped if a newer head has been delivered or is
+// pending.
+func (d *Depute) deliver(ctx context.Context, a *announcer, msg message.Message, seq uint64) error {
+	a.mu.Lock()
+	stale := seq < a.seq
+	a.mu.Unlock()
+	if stale {
+		logger.Debugw("Dropped announcement of older head", "sender", a.name, "link", msg.Cid)
+		return nil
+	}
+	err := a.sender.Send(ctx, msg)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if seq < a.seq {
+		// A newer head was delivered meanwhile, whose outcome stands.
+		return err
+	}
+	a.seq = seq
+	a.record(msg.Cid, err)
+	if err == nil {
+		logger.Debugw("Announced advertisement", "sender", a.name, "link", msg.Cid)
+		// A newer announcement supersedes any pending retry.
+		a.pending = nil
+		return nil
+	}
+	logger.Warnw("Failed to announce advertisement", "sender", a.name, "link", msg.Cid, "err", err)
+	if a.retry && !errors.Is(err, context.Canceled) {
+		a.pending = &msg
+		if !a.retrying {
+			a.retrying = true
+			d.wg.Add(1)
+			go d.retryLoop(a)
+		}
+	}
+	return err
+}
+
+// retryLoop retries the pending delivery of the announcer with exponential
+// backoff until it succeeds, it is superseded, or Shutdown.
+func (d *Depute) retryLoop(a *announcer) {
+	defer d.wg.Done()
+	backoff := d.announceRetryMinBackoff
+	for {
+		a.mu.Lock()
+		a.nextRetry = time.Now().Add(backoff)
+		a.mu.Unlock()
+		timer := time.NewTimer(backoff)
+		select {
+		case <-d.closing:
+			timer.Stop()
+			a.mu.Lock()
+			a.retrying = false
+			a.nextRetry = time.Time{}
+			a.mu.Unlock()
+			return
+		case <-timer.C:
+		}
+
+		a.mu.Lock()
+		msg := a.pending
+		if msg == nil {
+			a.retrying = false
+			a.nextRetry = time.Time{}
+			a.mu.Unlock()
+			return
+		}
+		a.mu.Unlock()
+
+		ctx, cancel := d.closingContext()
+		err := a.sender.Send(ctx, *msg)
+		cancel()
+
+		a.mu.Lock()
+		if a.pending != msg {
+			// A newer delivery, whose outcome is recorded, superseded the
+			// retry meanwhile.
+			if a.pending == nil {
+				a.retrying = false
+				a.nextRetry = time.Time{}
+				a.mu.Unlock()
+				return
+			}
+			backoff = d.announceRetryMinBackoff
+			a.mu.Unlock()
+			continue
+		}
+		a.record(msg.Cid, err)
+		if err == nil {
+			logger.Infow("Announced advertisement after retrying", "sender", a.name, "link", msg.Cid)
+			a.pending = nil
+			a.retrying = false
+			a.nextRetry = time.Time{}
+			a.mu.Unlock()
+			return
+		}
+		logger.Warnw("Failed to retry announcement", "sender", a.name, "link", msg.Cid, "failures", a.failures, "err", err)
+		backoff = min(2*backoff, d.announceRetryMaxBackoff)
+		a.mu.Unlock()
+	}
+}
+
+// record records the outcome of a delivery. The caller must hold a.mu.
+func (a *announcer) record(c cid.Cid, err error) {
+	now := time.Now()
+	a.cid = c
+	a.lastAttempt = now
+	a.lastErr = err
+	if err != nil {
+		a.failures++
+		return
+	}
+	a.lastSuccess = now
+	a.failures = 0
+}
+
+func (a *announcer) status() *depute.AnnounceStatus_Sender {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	s := depute.AnnounceStatus_Sender{
+		Name:     a.name,
+		Failures: a.failures,
+		Retrying: a.retrying,
+	}
+	if a.cid != cid.Undef {
+		s.Link = &depute.Link{Value: a.cid.Bytes()}
+	}
+	if a.lastErr != nil {
+		s.LastError = a.lastErr.Error()
+	}
+	if !a.lastAttempt.IsZero() {
+		s.LastAttempt = a.lastAttempt.Unix()
+	}
+	if !a.lastSuccess.IsZero() {
+		s.LastSuccess = a.lastSuccess.Unix()
+	}
+	if !a.nextRetry.IsZero() {
+		s.NextRetry = a.nextRetry.Unix()
+	}
+	return &s
+}
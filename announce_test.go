@@ -0,0 +1,79 @@
+package depute
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipni/go-libipni/announce/message"
+	"github.com/multiformats/go-multihash"
+)
+
+// testSender records the announcements sent, failing them while err is set.
+type testSender struct {
+	mu   sync.Mutex
+	err  error
+	sent []cid.Cid
+}
+
+func (s *testSender) Send(_ context.Context, msg message.Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.sent = append(s.sent, msg.Cid)
+	return nil
+}
+
+func (s *testSender) Close() error {
+	return nil
+}
+
+func testCid(t *testing.T, data string) cid.Cid {
+	t.Helper()
+	mh, err := multihash.Sum([]byte(data), multihash.SHA2_256, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cid.NewCidV1(cid.DagCBOR, mh)
+}
+
+func TestDeliverDropsOlderHeads(t *testing.T) {
+	d := newTestDepute(t)
+	sender := &testSender{err: errors.New("indexer down")}
+	a := &announcer{name: "test", sender: sender, retry: true}
+	older, newer := testCid(t, "older"), testCid(t, "newer")
+
+	// The newer head fails to be delivered, and is pending a retry.
+	if err := d.deliver(context.Background(), a, message.Message{Cid: newer}, 2); err == nil {
+		t.Fatal("expected delivery to fail")
+	}
+	sender.mu.Lock()
+	sender.err = nil
+	sender.mu.Unlock()
+
+	// The older head, announced late, is dropped and leaves the retry of the
+	// newer head pending.
+	if err := d.deliver(context.Background(), a, message.Message{Cid: older}, 1); err != nil {
+		t.Fatal(err)
+	}
+	sender.mu.Lock()
+	sent := sender.sent
+	sender.mu.Unlock()
+	if len(sent) != 0 {
+		t.Fatalf("expected older head to be dropped; sent %v", sent)
+	}
+	s := a.status()
+	if c, err := cid.Cast(s.GetLink().GetValue()); err != nil || c != newer {
+		t.Fatalf("expected status of %s; got %s", newer, c)
+	}
+	a.mu.Lock()
+	pending := a.pending
+	a.mu.Unlock()
+	if pending == nil || pending.Cid != newer {
+		t.Fatalf("expected retry of %s to be pending", newer)
+	}
+}
@@ -114,22 +114,76 @@ func (x *Multihash) GetValue() []byte {
 	return nil
 }
 
+type Metadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocols []*Metadata_Protocol `protobuf:"bytes,1,rep,name=protocols,proto3" json:"protocols,omitempty"`
+}
+
+func (x *Metadata) Reset() {
+	*x = Metadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Metadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metadata) ProtoMessage() {}
+
+func (x *Metadata) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metadata.ProtoReflect.Descriptor instead.
+func (*Metadata) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Metadata) GetProtocols() []*Metadata_Protocol {
+	if x != nil {
+		return x.Protocols
+	}
+	return nil
+}
+
 type Advertisement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID        *Link  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Entries   *Link  `protobuf:"bytes,2,opt,name=entries,proto3,oneof" json:"entries,omitempty"`
-	ContextId []byte `protobuf:"bytes,3,opt,name=context_id,json=contextId,proto3,oneof" json:"context_id,omitempty"`
-	Metadata  []byte `protobuf:"bytes,4,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
-	Removed   bool   `protobuf:"varint,5,opt,name=removed,proto3" json:"removed,omitempty"`
+	ID        *Link    `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Entries   *Link    `protobuf:"bytes,2,opt,name=entries,proto3,oneof" json:"entries,omitempty"`
+	ContextId []byte   `protobuf:"bytes,3,opt,name=context_id,json=contextId,proto3,oneof" json:"context_id,omitempty"`
+	Metadata  []byte   `protobuf:"bytes,4,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
+	Removed   bool     `protobuf:"varint,5,opt,name=removed,proto3" json:"removed,omitempty"`
+	Provider  string   `protobuf:"bytes,6,opt,name=provider,proto3" json:"provider,omitempty"`
+	Addresses []string `protobuf:"bytes,7,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Previous  *Link    `protobuf:"bytes,8,opt,name=previous,proto3" json:"previous,omitempty"`
+	Signature []byte   `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Retrieval protocols encoded by depute as the advertisement metadata.
+	// Mutually exclusive with metadata, which is used as is.
+	TypedMetadata *Metadata `protobuf:"bytes,10,opt,name=typed_metadata,json=typedMetadata,proto3" json:"typed_metadata,omitempty"`
 }
 
 func (x *Advertisement) Reset() {
 	*x = Advertisement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_depute_proto_msgTypes[2]
+		mi := &file_depute_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -142,7 +196,7 @@ func (x *Advertisement) String() string {
 func (*Advertisement) ProtoMessage() {}
 
 func (x *Advertisement) ProtoReflect() protoreflect.Message {
-	mi := &file_depute_proto_msgTypes[2]
+	mi := &file_depute_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -155,7 +209,7 @@ func (x *Advertisement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Advertisement.ProtoReflect.Descriptor instead.
 func (*Advertisement) Descriptor() ([]byte, []int) {
-	return file_depute_proto_rawDescGZIP(), []int{2}
+	return file_depute_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Advertisement) GetID() *Link {
@@ -193,6 +247,122 @@ func (x *Advertisement) GetRemoved() bool {
 	return false
 }
 
+func (x *Advertisement) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Advertisement) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *Advertisement) GetPrevious() *Link {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *Advertisement) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *Advertisement) GetTypedMetadata() *Metadata {
+	if x != nil {
+		return x.TypedMetadata
+	}
+	return nil
+}
+
+type ContextState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContextId []byte `protobuf:"bytes,1,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
+	// The latest advertisement published for the context ID.
+	Advertisement *Link `protobuf:"bytes,2,opt,name=advertisement,proto3" json:"advertisement,omitempty"`
+	// The entries of the context ID; unset if it has none.
+	Entries  *Link  `protobuf:"bytes,3,opt,name=entries,proto3,oneof" json:"entries,omitempty"`
+	Metadata []byte `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Removed  bool   `protobuf:"varint,5,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (x *ContextState) Reset() {
+	*x = ContextState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContextState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContextState) ProtoMessage() {}
+
+func (x *ContextState) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContextState.ProtoReflect.Descriptor instead.
+func (*ContextState) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ContextState) GetContextId() []byte {
+	if x != nil {
+		return x.ContextId
+	}
+	return nil
+}
+
+func (x *ContextState) GetAdvertisement() *Link {
+	if x != nil {
+		return x.Advertisement
+	}
+	return nil
+}
+
+func (x *ContextState) GetEntries() *Link {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ContextState) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ContextState) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
 type NotifyContent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -202,7 +372,7 @@ type NotifyContent struct {
 func (x *NotifyContent) Reset() {
 	*x = NotifyContent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_depute_proto_msgTypes[3]
+		mi := &file_depute_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -215,7 +385,7 @@ func (x *NotifyContent) String() string {
 func (*NotifyContent) ProtoMessage() {}
 
 func (x *NotifyContent) ProtoReflect() protoreflect.Message {
-	mi := &file_depute_proto_msgTypes[3]
+	mi := &file_depute_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,32 +398,35 @@ func (x *NotifyContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyContent.ProtoReflect.Descriptor instead.
 func (*NotifyContent) Descriptor() ([]byte, []int) {
-	return file_depute_proto_rawDescGZIP(), []int{3}
+	return file_depute_proto_rawDescGZIP(), []int{5}
 }
 
-type Publish struct {
+// NotifyContentStream is the bidirectional variant of NotifyContent, which
+// periodically reports the progress of the stream. Its requests are the same
+// as NotifyContent.
+type NotifyContentStream struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Publish) Reset() {
-	*x = Publish{}
+func (x *NotifyContentStream) Reset() {
+	*x = NotifyContentStream{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_depute_proto_msgTypes[4]
+		mi := &file_depute_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Publish) String() string {
+func (x *NotifyContentStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Publish) ProtoMessage() {}
+func (*NotifyContentStream) ProtoMessage() {}
 
-func (x *Publish) ProtoReflect() protoreflect.Message {
-	mi := &file_depute_proto_msgTypes[4]
+func (x *NotifyContentStream) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,36 +437,36 @@ func (x *Publish) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Publish.ProtoReflect.Descriptor instead.
-func (*Publish) Descriptor() ([]byte, []int) {
-	return file_depute_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use NotifyContentStream.ProtoReflect.Descriptor instead.
+func (*NotifyContentStream) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{6}
 }
 
-type NotifyContent_Request struct {
+// Advertise stores the multihashes streamed as the entries of an
+// advertisement, and publishes the advertisement in a single call.
+type Advertise struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Multihash *Multihash `protobuf:"bytes,1,opt,name=multihash,proto3" json:"multihash,omitempty"`
 }
 
-func (x *NotifyContent_Request) Reset() {
-	*x = NotifyContent_Request{}
+func (x *Advertise) Reset() {
+	*x = Advertise{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_depute_proto_msgTypes[5]
+		mi := &file_depute_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NotifyContent_Request) String() string {
+func (x *Advertise) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotifyContent_Request) ProtoMessage() {}
+func (*Advertise) ProtoMessage() {}
 
-func (x *NotifyContent_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_depute_proto_msgTypes[5]
+func (x *Advertise) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,43 +477,50 @@ func (x *NotifyContent_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotifyContent_Request.ProtoReflect.Descriptor instead.
-func (*NotifyContent_Request) Descriptor() ([]byte, []int) {
-	return file_depute_proto_rawDescGZIP(), []int{3, 0}
-}
-
-func (x *NotifyContent_Request) GetMultihash() *Multihash {
-	if x != nil {
-		return x.Multihash
-	}
-	return nil
+// Deprecated: Use Advertise.ProtoReflect.Descriptor instead.
+func (*Advertise) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{7}
 }
 
-type NotifyContent_Response struct {
+// ContentSession is the state of a resumable NotifyContent upload.
+type ContentSession struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Options *NotifyContent_Options `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	// The number of multihashes received up to the last entries chunk stored,
+	// i.e. the index from which an interrupted upload is resumed.
+	Offset uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The link to the last entries chunk stored, if any. Once the session is
+	// complete, this is the link to the entries.
+	Link       *Link  `protobuf:"bytes,4,opt,name=link,proto3,oneof" json:"link,omitempty"`
+	Complete   bool   `protobuf:"varint,5,opt,name=complete,proto3" json:"complete,omitempty"`
+	Skipped    uint64 `protobuf:"varint,6,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Duplicates uint64 `protobuf:"varint,7,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+	// The time of the last update to the session, in seconds since the Unix
+	// epoch.
+	Updated int64 `protobuf:"varint,8,opt,name=updated,proto3" json:"updated,omitempty"`
 }
 
-func (x *NotifyContent_Response) Reset() {
-	*x = NotifyContent_Response{}
+func (x *ContentSession) Reset() {
+	*x = ContentSession{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_depute_proto_msgTypes[6]
+		mi := &file_depute_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NotifyContent_Response) String() string {
+func (x *ContentSession) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotifyContent_Response) ProtoMessage() {}
+func (*ContentSession) ProtoMessage() {}
 
-func (x *NotifyContent_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_depute_proto_msgTypes[6]
+func (x *ContentSession) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,43 +531,90 @@ func (x *NotifyContent_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotifyContent_Response.ProtoReflect.Descriptor instead.
-func (*NotifyContent_Response) Descriptor() ([]byte, []int) {
-	return file_depute_proto_rawDescGZIP(), []int{3, 1}
+// Deprecated: Use ContentSession.ProtoReflect.Descriptor instead.
+func (*ContentSession) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *NotifyContent_Response) GetLink() *Link {
+func (x *ContentSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContentSession) GetOptions() *NotifyContent_Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ContentSession) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ContentSession) GetLink() *Link {
 	if x != nil {
 		return x.Link
 	}
 	return nil
 }
 
-type Publish_Request struct {
+func (x *ContentSession) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *ContentSession) GetSkipped() uint64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ContentSession) GetDuplicates() uint64 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+func (x *ContentSession) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+type ResumeContent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Advertisement *Advertisement `protobuf:"bytes,1,opt,name=advertisement,proto3" json:"advertisement,omitempty"`
 }
 
-func (x *Publish_Request) Reset() {
-	*x = Publish_Request{}
+func (x *ResumeContent) Reset() {
+	*x = ResumeContent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_depute_proto_msgTypes[7]
+		mi := &file_depute_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Publish_Request) String() string {
+func (x *ResumeContent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Publish_Request) ProtoMessage() {}
+func (*ResumeContent) ProtoMessage() {}
 
-func (x *Publish_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_depute_proto_msgTypes[7]
+func (x *ResumeContent) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,43 +625,34 @@ func (x *Publish_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Publish_Request.ProtoReflect.Descriptor instead.
-func (*Publish_Request) Descriptor() ([]byte, []int) {
-	return file_depute_proto_rawDescGZIP(), []int{4, 0}
-}
-
-func (x *Publish_Request) GetAdvertisement() *Advertisement {
-	if x != nil {
-		return x.Advertisement
-	}
-	return nil
+// Deprecated: Use ResumeContent.ProtoReflect.Descriptor instead.
+func (*ResumeContent) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{9}
 }
 
-type Publish_Response struct {
+type Publish struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
 }
 
-func (x *Publish_Response) Reset() {
-	*x = Publish_Response{}
+func (x *Publish) Reset() {
+	*x = Publish{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_depute_proto_msgTypes[8]
+		mi := &file_depute_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Publish_Response) String() string {
+func (x *Publish) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Publish_Response) ProtoMessage() {}
+func (*Publish) ProtoMessage() {}
 
-func (x *Publish_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_depute_proto_msgTypes[8]
+func (x *Publish) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,127 +663,4283 @@ func (x *Publish_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Publish_Response.ProtoReflect.Descriptor instead.
-func (*Publish_Response) Descriptor() ([]byte, []int) {
-	return file_depute_proto_rawDescGZIP(), []int{4, 1}
-}
-
-func (x *Publish_Response) GetLink() *Link {
-	if x != nil {
-		return x.Link
-	}
-	return nil
+// Deprecated: Use Publish.ProtoReflect.Descriptor instead.
+func (*Publish) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{10}
 }
 
-var File_depute_proto protoreflect.FileDescriptor
+// BatchPublish publishes advertisements chained in order with a single
+// update of the chain head. Either all or none of the advertisements are
+// published.
+type BatchPublish struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
 
-var file_depute_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e,
-	0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x22, 0x1c,
-	0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x21, 0x0a, 0x09,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0xf1, 0x01, 0x0a, 0x0d, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x24, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c,
-	0x69, 0x6e, 0x6b, 0x52, 0x02, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e,
-	0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00,
-	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x48, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01,
-	0x12, 0x1f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0c, 0x48, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01,
-	0x01, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x89, 0x01, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x42, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x37, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74,
-	0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x61, 0x73, 0x68, 0x52, 0x09,
-	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x61, 0x73, 0x68, 0x1a, 0x34, 0x0a, 0x08, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74,
-	0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22,
-	0x8f, 0x01, 0x0a, 0x07, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x1a, 0x4e, 0x0a, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74,
-	0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x41,
-	0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x61, 0x64,
-	0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x34, 0x0a, 0x08, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70,
-	0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x04, 0x6c, 0x69, 0x6e,
-	0x6b, 0x32, 0xbb, 0x01, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x12,
-	0x60, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x25, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76,
-	0x30, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64,
-	0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
-	0x01, 0x12, 0x4c, 0x0a, 0x07, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x1f, 0x2e, 0x69,
-	0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x73, 0x68, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
-	0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x70,
-	0x6e, 0x69, 0x2f, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x30,
-	0x3b, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *BatchPublish) Reset() {
+	*x = BatchPublish{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var (
-	file_depute_proto_rawDescOnce sync.Once
-	file_depute_proto_rawDescData = file_depute_proto_rawDesc
-)
+func (x *BatchPublish) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_depute_proto_rawDescGZIP() []byte {
-	file_depute_proto_rawDescOnce.Do(func() {
-		file_depute_proto_rawDescData = protoimpl.X.CompressGZIP(file_depute_proto_rawDescData)
-	})
-	return file_depute_proto_rawDescData
+func (*BatchPublish) ProtoMessage() {}
+
+func (x *BatchPublish) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_depute_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
-var file_depute_proto_goTypes = []interface{}{
-	(*Link)(nil),                   // 0: ipni.depute.v0.Link
-	(*Multihash)(nil),              // 1: ipni.depute.v0.Multihash
-	(*Advertisement)(nil),          // 2: ipni.depute.v0.Advertisement
-	(*NotifyContent)(nil),          // 3: ipni.depute.v0.NotifyContent
-	(*Publish)(nil),                // 4: ipni.depute.v0.Publish
-	(*NotifyContent_Request)(nil),  // 5: ipni.depute.v0.NotifyContent.Request
-	(*NotifyContent_Response)(nil), // 6: ipni.depute.v0.NotifyContent.Response
-	(*Publish_Request)(nil),        // 7: ipni.depute.v0.Publish.Request
-	(*Publish_Response)(nil),       // 8: ipni.depute.v0.Publish.Response
+// Deprecated: Use BatchPublish.ProtoReflect.Descriptor instead.
+func (*BatchPublish) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{11}
 }
-var file_depute_proto_depIdxs = []int32{
-	0, // 0: ipni.depute.v0.Advertisement.ID:type_name -> ipni.depute.v0.Link
-	0, // 1: ipni.depute.v0.Advertisement.entries:type_name -> ipni.depute.v0.Link
-	1, // 2: ipni.depute.v0.NotifyContent.Request.multihash:type_name -> ipni.depute.v0.Multihash
-	0, // 3: ipni.depute.v0.NotifyContent.Response.link:type_name -> ipni.depute.v0.Link
-	2, // 4: ipni.depute.v0.Publish.Request.advertisement:type_name -> ipni.depute.v0.Advertisement
-	0, // 5: ipni.depute.v0.Publish.Response.link:type_name -> ipni.depute.v0.Link
-	5, // 6: ipni.depute.v0.Publisher.NotifyContent:input_type -> ipni.depute.v0.NotifyContent.Request
-	7, // 7: ipni.depute.v0.Publisher.Publish:input_type -> ipni.depute.v0.Publish.Request
-	6, // 8: ipni.depute.v0.Publisher.NotifyContent:output_type -> ipni.depute.v0.NotifyContent.Response
-	8, // 9: ipni.depute.v0.Publisher.Publish:output_type -> ipni.depute.v0.Publish.Response
-	8, // [8:10] is the sub-list for method output_type
-	6, // [6:8] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+
+// UpdateMetadata publishes an advertisement that changes the metadata of a
+// context ID, keeping its entries. The context ID must have been advertised
+// and not removed.
+type UpdateMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func init() { file_depute_proto_init() }
-func file_depute_proto_init() {
-	if File_depute_proto != nil {
-		return
+func (x *UpdateMetadata) Reset() {
+	*x = UpdateMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_depute_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Link); i {
+}
+
+func (x *UpdateMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMetadata) ProtoMessage() {}
+
+func (x *UpdateMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMetadata.ProtoReflect.Descriptor instead.
+func (*UpdateMetadata) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{12}
+}
+
+// RemoveContext publishes removal advertisements for live context IDs with a
+// single update of the chain head. Either all or none of the context IDs are
+// removed.
+type RemoveContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveContext) Reset() {
+	*x = RemoveContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveContext) ProtoMessage() {}
+
+func (x *RemoveContext) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveContext.ProtoReflect.Descriptor instead.
+func (*RemoveContext) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{13}
+}
+
+type GetHead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetHead) Reset() {
+	*x = GetHead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHead) ProtoMessage() {}
+
+func (x *GetHead) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHead.ProtoReflect.Descriptor instead.
+func (*GetHead) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{14}
+}
+
+type GetAdvertisement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetAdvertisement) Reset() {
+	*x = GetAdvertisement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAdvertisement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdvertisement) ProtoMessage() {}
+
+func (x *GetAdvertisement) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdvertisement.ProtoReflect.Descriptor instead.
+func (*GetAdvertisement) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{15}
+}
+
+type ListAdvertisements struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListAdvertisements) Reset() {
+	*x = ListAdvertisements{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAdvertisements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdvertisements) ProtoMessage() {}
+
+func (x *ListAdvertisements) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdvertisements.ProtoReflect.Descriptor instead.
+func (*ListAdvertisements) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{16}
+}
+
+type GetEntries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetEntries) Reset() {
+	*x = GetEntries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEntries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntries) ProtoMessage() {}
+
+func (x *GetEntries) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntries.ProtoReflect.Descriptor instead.
+func (*GetEntries) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{17}
+}
+
+type GetContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetContext) Reset() {
+	*x = GetContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContext) ProtoMessage() {}
+
+func (x *GetContext) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContext.ProtoReflect.Descriptor instead.
+func (*GetContext) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{18}
+}
+
+type ListContexts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListContexts) Reset() {
+	*x = ListContexts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContexts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContexts) ProtoMessage() {}
+
+func (x *ListContexts) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContexts.ProtoReflect.Descriptor instead.
+func (*ListContexts) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{19}
+}
+
+type CollectGarbage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CollectGarbage) Reset() {
+	*x = CollectGarbage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectGarbage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectGarbage) ProtoMessage() {}
+
+func (x *CollectGarbage) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectGarbage.ProtoReflect.Descriptor instead.
+func (*CollectGarbage) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{20}
+}
+
+type Export struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Export) Reset() {
+	*x = Export{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Export) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Export) ProtoMessage() {}
+
+func (x *Export) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Export.ProtoReflect.Descriptor instead.
+func (*Export) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{21}
+}
+
+type Import struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Import) Reset() {
+	*x = Import{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Import) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Import) ProtoMessage() {}
+
+func (x *Import) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Import.ProtoReflect.Descriptor instead.
+func (*Import) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{22}
+}
+
+type Reannounce struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Reannounce) Reset() {
+	*x = Reannounce{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reannounce) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reannounce) ProtoMessage() {}
+
+func (x *Reannounce) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reannounce.ProtoReflect.Descriptor instead.
+func (*Reannounce) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{23}
+}
+
+type AnnounceStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AnnounceStatus) Reset() {
+	*x = AnnounceStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnounceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnounceStatus) ProtoMessage() {}
+
+func (x *AnnounceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnounceStatus.ProtoReflect.Descriptor instead.
+func (*AnnounceStatus) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{24}
+}
+
+type Metadata_Bitswap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Metadata_Bitswap) Reset() {
+	*x = Metadata_Bitswap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Metadata_Bitswap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metadata_Bitswap) ProtoMessage() {}
+
+func (x *Metadata_Bitswap) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metadata_Bitswap.ProtoReflect.Descriptor instead.
+func (*Metadata_Bitswap) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type Metadata_GraphsyncFilecoinV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PieceCid      []byte `protobuf:"bytes,1,opt,name=piece_cid,json=pieceCid,proto3" json:"piece_cid,omitempty"`
+	VerifiedDeal  bool   `protobuf:"varint,2,opt,name=verified_deal,json=verifiedDeal,proto3" json:"verified_deal,omitempty"`
+	FastRetrieval bool   `protobuf:"varint,3,opt,name=fast_retrieval,json=fastRetrieval,proto3" json:"fast_retrieval,omitempty"`
+}
+
+func (x *Metadata_GraphsyncFilecoinV1) Reset() {
+	*x = Metadata_GraphsyncFilecoinV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Metadata_GraphsyncFilecoinV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metadata_GraphsyncFilecoinV1) ProtoMessage() {}
+
+func (x *Metadata_GraphsyncFilecoinV1) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metadata_GraphsyncFilecoinV1.ProtoReflect.Descriptor instead.
+func (*Metadata_GraphsyncFilecoinV1) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Metadata_GraphsyncFilecoinV1) GetPieceCid() []byte {
+	if x != nil {
+		return x.PieceCid
+	}
+	return nil
+}
+
+func (x *Metadata_GraphsyncFilecoinV1) GetVerifiedDeal() bool {
+	if x != nil {
+		return x.VerifiedDeal
+	}
+	return false
+}
+
+func (x *Metadata_GraphsyncFilecoinV1) GetFastRetrieval() bool {
+	if x != nil {
+		return x.FastRetrieval
+	}
+	return false
+}
+
+type Metadata_IpfsGatewayHttp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Metadata_IpfsGatewayHttp) Reset() {
+	*x = Metadata_IpfsGatewayHttp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Metadata_IpfsGatewayHttp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metadata_IpfsGatewayHttp) ProtoMessage() {}
+
+func (x *Metadata_IpfsGatewayHttp) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metadata_IpfsGatewayHttp.ProtoReflect.Descriptor instead.
+func (*Metadata_IpfsGatewayHttp) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{2, 2}
+}
+
+type Metadata_Protocol struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Protocol:
+	//	*Metadata_Protocol_Bitswap
+	//	*Metadata_Protocol_GraphsyncFilecoinv1
+	//	*Metadata_Protocol_IpfsGatewayHttp
+	Protocol isMetadata_Protocol_Protocol `protobuf_oneof:"protocol"`
+}
+
+func (x *Metadata_Protocol) Reset() {
+	*x = Metadata_Protocol{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Metadata_Protocol) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metadata_Protocol) ProtoMessage() {}
+
+func (x *Metadata_Protocol) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metadata_Protocol.ProtoReflect.Descriptor instead.
+func (*Metadata_Protocol) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (m *Metadata_Protocol) GetProtocol() isMetadata_Protocol_Protocol {
+	if m != nil {
+		return m.Protocol
+	}
+	return nil
+}
+
+func (x *Metadata_Protocol) GetBitswap() *Metadata_Bitswap {
+	if x, ok := x.GetProtocol().(*Metadata_Protocol_Bitswap); ok {
+		return x.Bitswap
+	}
+	return nil
+}
+
+func (x *Metadata_Protocol) GetGraphsyncFilecoinv1() *Metadata_GraphsyncFilecoinV1 {
+	if x, ok := x.GetProtocol().(*Metadata_Protocol_GraphsyncFilecoinv1); ok {
+		return x.GraphsyncFilecoinv1
+	}
+	return nil
+}
+
+func (x *Metadata_Protocol) GetIpfsGatewayHttp() *Metadata_IpfsGatewayHttp {
+	if x, ok := x.GetProtocol().(*Metadata_Protocol_IpfsGatewayHttp); ok {
+		return x.IpfsGatewayHttp
+	}
+	return nil
+}
+
+type isMetadata_Protocol_Protocol interface {
+	isMetadata_Protocol_Protocol()
+}
+
+type Metadata_Protocol_Bitswap struct {
+	Bitswap *Metadata_Bitswap `protobuf:"bytes,1,opt,name=bitswap,proto3,oneof"`
+}
+
+type Metadata_Protocol_GraphsyncFilecoinv1 struct {
+	GraphsyncFilecoinv1 *Metadata_GraphsyncFilecoinV1 `protobuf:"bytes,2,opt,name=graphsync_filecoinv1,json=graphsyncFilecoinv1,proto3,oneof"`
+}
+
+type Metadata_Protocol_IpfsGatewayHttp struct {
+	IpfsGatewayHttp *Metadata_IpfsGatewayHttp `protobuf:"bytes,3,opt,name=ipfs_gateway_http,json=ipfsGatewayHttp,proto3,oneof"`
+}
+
+func (*Metadata_Protocol_Bitswap) isMetadata_Protocol_Protocol() {}
+
+func (*Metadata_Protocol_GraphsyncFilecoinv1) isMetadata_Protocol_Protocol() {}
+
+func (*Metadata_Protocol_IpfsGatewayHttp) isMetadata_Protocol_Protocol() {}
+
+type NotifyContent_Options struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Skip and count invalid multihashes instead of failing the stream.
+	SkipInvalid bool `protobuf:"varint,1,opt,name=skip_invalid,json=skipInvalid,proto3" json:"skip_invalid,omitempty"`
+	// Drop multihashes already received on the stream.
+	Deduplicate bool `protobuf:"varint,2,opt,name=deduplicate,proto3" json:"deduplicate,omitempty"`
+	// Identifies a resumable upload session. The progress of the upload is
+	// persisted as entries chunks are stored, so that an interrupted upload
+	// can be resumed from the offset returned by ResumeContent. Options of a
+	// resumed session are those it was started with.
+	SessionId string `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The index of the first multihash sent when resuming a session, which
+	// must match the offset of the session.
+	Offset uint64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *NotifyContent_Options) Reset() {
+	*x = NotifyContent_Options{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotifyContent_Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyContent_Options) ProtoMessage() {}
+
+func (x *NotifyContent_Options) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyContent_Options.ProtoReflect.Descriptor instead.
+func (*NotifyContent_Options) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *NotifyContent_Options) GetSkipInvalid() bool {
+	if x != nil {
+		return x.SkipInvalid
+	}
+	return false
+}
+
+func (x *NotifyContent_Options) GetDeduplicate() bool {
+	if x != nil {
+		return x.Deduplicate
+	}
+	return false
+}
+
+func (x *NotifyContent_Options) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *NotifyContent_Options) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type NotifyContent_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Multihash *Multihash `protobuf:"bytes,1,opt,name=multihash,proto3" json:"multihash,omitempty"`
+	// Options of the stream, only read from the first request.
+	Options *NotifyContent_Options `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *NotifyContent_Request) Reset() {
+	*x = NotifyContent_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotifyContent_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyContent_Request) ProtoMessage() {}
+
+func (x *NotifyContent_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyContent_Request.ProtoReflect.Descriptor instead.
+func (*NotifyContent_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *NotifyContent_Request) GetMultihash() *Multihash {
+	if x != nil {
+		return x.Multihash
+	}
+	return nil
+}
+
+func (x *NotifyContent_Request) GetOptions() *NotifyContent_Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type NotifyContent_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	// The number of invalid multihashes skipped.
+	Skipped uint64 `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// The number of duplicate multihashes dropped.
+	Duplicates uint64 `protobuf:"varint,3,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+}
+
+func (x *NotifyContent_Response) Reset() {
+	*x = NotifyContent_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotifyContent_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyContent_Response) ProtoMessage() {}
+
+func (x *NotifyContent_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyContent_Response.ProtoReflect.Descriptor instead.
+func (*NotifyContent_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{5, 2}
+}
+
+func (x *NotifyContent_Response) GetLink() *Link {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *NotifyContent_Response) GetSkipped() uint64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *NotifyContent_Response) GetDuplicates() uint64 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+type NotifyContentStream_Progress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of multihashes received.
+	Received uint64 `protobuf:"varint,1,opt,name=received,proto3" json:"received,omitempty"`
+	// The number of entries chunks stored.
+	Chunks uint64 `protobuf:"varint,2,opt,name=chunks,proto3" json:"chunks,omitempty"`
+	// The number of invalid multihashes skipped.
+	Skipped uint64 `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// The number of duplicate multihashes dropped.
+	Duplicates uint64 `protobuf:"varint,4,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+}
+
+func (x *NotifyContentStream_Progress) Reset() {
+	*x = NotifyContentStream_Progress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotifyContentStream_Progress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyContentStream_Progress) ProtoMessage() {}
+
+func (x *NotifyContentStream_Progress) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyContentStream_Progress.ProtoReflect.Descriptor instead.
+func (*NotifyContentStream_Progress) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *NotifyContentStream_Progress) GetReceived() uint64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *NotifyContentStream_Progress) GetChunks() uint64 {
+	if x != nil {
+		return x.Chunks
+	}
+	return 0
+}
+
+func (x *NotifyContentStream_Progress) GetSkipped() uint64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *NotifyContentStream_Progress) GetDuplicates() uint64 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+type NotifyContentStream_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Progress *NotifyContentStream_Progress `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	// The link to the entries, only set in the last response, once the client
+	// has closed the stream.
+	Link *Link `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (x *NotifyContentStream_Response) Reset() {
+	*x = NotifyContentStream_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotifyContentStream_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyContentStream_Response) ProtoMessage() {}
+
+func (x *NotifyContentStream_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyContentStream_Response.ProtoReflect.Descriptor instead.
+func (*NotifyContentStream_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *NotifyContentStream_Response) GetProgress() *NotifyContentStream_Progress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *NotifyContentStream_Response) GetLink() *Link {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type Advertise_Header struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The advertisement to publish, which must not be a removal. Its entries
+	// are the multihashes streamed after the header, and must be unset. If no
+	// multihashes are streamed, the advertisement only updates the metadata of
+	// its context ID, which must have been advertised and not removed.
+	Advertisement *Advertisement `protobuf:"bytes,1,opt,name=advertisement,proto3" json:"advertisement,omitempty"`
+	// Options of the multihashes stream.
+	Options *NotifyContent_Options `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	// When set, advertise fails with FAILED_PRECONDITION unless the chain head
+	// is this link. An empty link expects an empty chain.
+	ExpectedPrevious *Link `protobuf:"bytes,3,opt,name=expected_previous,json=expectedPrevious,proto3,oneof" json:"expected_previous,omitempty"`
+}
+
+func (x *Advertise_Header) Reset() {
+	*x = Advertise_Header{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Advertise_Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Advertise_Header) ProtoMessage() {}
+
+func (x *Advertise_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Advertise_Header.ProtoReflect.Descriptor instead.
+func (*Advertise_Header) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *Advertise_Header) GetAdvertisement() *Advertisement {
+	if x != nil {
+		return x.Advertisement
+	}
+	return nil
+}
+
+func (x *Advertise_Header) GetOptions() *NotifyContent_Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Advertise_Header) GetExpectedPrevious() *Link {
+	if x != nil {
+		return x.ExpectedPrevious
+	}
+	return nil
+}
+
+type Advertise_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The first request carries the header, and the following requests carry
+	// multihashes.
+	//
+	// Types that are assignable to Message:
+	//	*Advertise_Request_Header
+	//	*Advertise_Request_Multihash
+	Message isAdvertise_Request_Message `protobuf_oneof:"message"`
+}
+
+func (x *Advertise_Request) Reset() {
+	*x = Advertise_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Advertise_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Advertise_Request) ProtoMessage() {}
+
+func (x *Advertise_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Advertise_Request.ProtoReflect.Descriptor instead.
+func (*Advertise_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (m *Advertise_Request) GetMessage() isAdvertise_Request_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *Advertise_Request) GetHeader() *Advertise_Header {
+	if x, ok := x.GetMessage().(*Advertise_Request_Header); ok {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Advertise_Request) GetMultihash() *Multihash {
+	if x, ok := x.GetMessage().(*Advertise_Request_Multihash); ok {
+		return x.Multihash
+	}
+	return nil
+}
+
+type isAdvertise_Request_Message interface {
+	isAdvertise_Request_Message()
+}
+
+type Advertise_Request_Header struct {
+	Header *Advertise_Header `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type Advertise_Request_Multihash struct {
+	Multihash *Multihash `protobuf:"bytes,2,opt,name=multihash,proto3,oneof"`
+}
+
+func (*Advertise_Request_Header) isAdvertise_Request_Message() {}
+
+func (*Advertise_Request_Multihash) isAdvertise_Request_Message() {}
+
+type Advertise_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The link to the published advertisement.
+	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	// The link to the entries of the advertisement, unset if no multihashes
+	// were streamed.
+	Entries *Link `protobuf:"bytes,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	// The number of invalid multihashes skipped.
+	Skipped uint64 `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// The number of duplicate multihashes dropped.
+	Duplicates uint64 `protobuf:"varint,4,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+}
+
+func (x *Advertise_Response) Reset() {
+	*x = Advertise_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Advertise_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Advertise_Response) ProtoMessage() {}
+
+func (x *Advertise_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Advertise_Response.ProtoReflect.Descriptor instead.
+func (*Advertise_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{7, 2}
+}
+
+func (x *Advertise_Response) GetLink() *Link {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *Advertise_Response) GetEntries() *Link {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *Advertise_Response) GetSkipped() uint64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *Advertise_Response) GetDuplicates() uint64 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+type ResumeContent_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *ResumeContent_Request) Reset() {
+	*x = ResumeContent_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeContent_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeContent_Request) ProtoMessage() {}
+
+func (x *ResumeContent_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeContent_Request.ProtoReflect.Descriptor instead.
+func (*ResumeContent_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *ResumeContent_Request) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type ResumeContent_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session *ContentSession `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *ResumeContent_Response) Reset() {
+	*x = ResumeContent_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeContent_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeContent_Response) ProtoMessage() {}
+
+func (x *ResumeContent_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeContent_Response.ProtoReflect.Descriptor instead.
+func (*ResumeContent_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *ResumeContent_Response) GetSession() *ContentSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type Publish_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Advertisement *Advertisement `protobuf:"bytes,1,opt,name=advertisement,proto3" json:"advertisement,omitempty"`
+	// When set, publish fails with FAILED_PRECONDITION unless the chain head
+	// is this link. An empty link expects an empty chain.
+	ExpectedPrevious *Link `protobuf:"bytes,2,opt,name=expected_previous,json=expectedPrevious,proto3,oneof" json:"expected_previous,omitempty"`
+	// Publish the advertisement without announcing it, e.g. when publishing
+	// a batch of advertisements to be announced by Reannounce once done.
+	SkipAnnounce bool `protobuf:"varint,3,opt,name=skip_announce,json=skipAnnounce,proto3" json:"skip_announce,omitempty"`
+}
+
+func (x *Publish_Request) Reset() {
+	*x = Publish_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Publish_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Publish_Request) ProtoMessage() {}
+
+func (x *Publish_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Publish_Request.ProtoReflect.Descriptor instead.
+func (*Publish_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *Publish_Request) GetAdvertisement() *Advertisement {
+	if x != nil {
+		return x.Advertisement
+	}
+	return nil
+}
+
+func (x *Publish_Request) GetExpectedPrevious() *Link {
+	if x != nil {
+		return x.ExpectedPrevious
+	}
+	return nil
+}
+
+func (x *Publish_Request) GetSkipAnnounce() bool {
+	if x != nil {
+		return x.SkipAnnounce
+	}
+	return false
+}
+
+type Publish_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (x *Publish_Response) Reset() {
+	*x = Publish_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Publish_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Publish_Response) ProtoMessage() {}
+
+func (x *Publish_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Publish_Response.ProtoReflect.Descriptor instead.
+func (*Publish_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{10, 1}
+}
+
+func (x *Publish_Response) GetLink() *Link {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type BatchPublish_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Advertisements []*Advertisement `protobuf:"bytes,1,rep,name=advertisements,proto3" json:"advertisements,omitempty"`
+	// When set, publish fails with FAILED_PRECONDITION unless the chain head
+	// is this link. An empty link expects an empty chain.
+	ExpectedPrevious *Link `protobuf:"bytes,2,opt,name=expected_previous,json=expectedPrevious,proto3,oneof" json:"expected_previous,omitempty"`
+	// Publish the advertisements without announcing the new chain head.
+	SkipAnnounce bool `protobuf:"varint,3,opt,name=skip_announce,json=skipAnnounce,proto3" json:"skip_announce,omitempty"`
+}
+
+func (x *BatchPublish_Request) Reset() {
+	*x = BatchPublish_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchPublish_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPublish_Request) ProtoMessage() {}
+
+func (x *BatchPublish_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPublish_Request.ProtoReflect.Descriptor instead.
+func (*BatchPublish_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *BatchPublish_Request) GetAdvertisements() []*Advertisement {
+	if x != nil {
+		return x.Advertisements
+	}
+	return nil
+}
+
+func (x *BatchPublish_Request) GetExpectedPrevious() *Link {
+	if x != nil {
+		return x.ExpectedPrevious
+	}
+	return nil
+}
+
+func (x *BatchPublish_Request) GetSkipAnnounce() bool {
+	if x != nil {
+		return x.SkipAnnounce
+	}
+	return false
+}
+
+type BatchPublish_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The links to the published advertisements, in order.
+	Links []*Link `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+}
+
+func (x *BatchPublish_Response) Reset() {
+	*x = BatchPublish_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchPublish_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchPublish_Response) ProtoMessage() {}
+
+func (x *BatchPublish_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchPublish_Response.ProtoReflect.Descriptor instead.
+func (*BatchPublish_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{11, 1}
+}
+
+func (x *BatchPublish_Response) GetLinks() []*Link {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type UpdateMetadata_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContextId []byte `protobuf:"bytes,1,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
+	// The new metadata, used as is. Mutually exclusive with typed_metadata.
+	Metadata []byte `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// The new retrieval protocols, encoded by depute as the metadata.
+	TypedMetadata *Metadata `protobuf:"bytes,3,opt,name=typed_metadata,json=typedMetadata,proto3" json:"typed_metadata,omitempty"`
+	// When set, the update fails with FAILED_PRECONDITION unless the chain
+	// head is this link.
+	ExpectedPrevious *Link `protobuf:"bytes,4,opt,name=expected_previous,json=expectedPrevious,proto3,oneof" json:"expected_previous,omitempty"`
+}
+
+func (x *UpdateMetadata_Request) Reset() {
+	*x = UpdateMetadata_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMetadata_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMetadata_Request) ProtoMessage() {}
+
+func (x *UpdateMetadata_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMetadata_Request.ProtoReflect.Descriptor instead.
+func (*UpdateMetadata_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *UpdateMetadata_Request) GetContextId() []byte {
+	if x != nil {
+		return x.ContextId
+	}
+	return nil
+}
+
+func (x *UpdateMetadata_Request) GetMetadata() []byte {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *UpdateMetadata_Request) GetTypedMetadata() *Metadata {
+	if x != nil {
+		return x.TypedMetadata
+	}
+	return nil
+}
+
+func (x *UpdateMetadata_Request) GetExpectedPrevious() *Link {
+	if x != nil {
+		return x.ExpectedPrevious
+	}
+	return nil
+}
+
+type UpdateMetadata_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (x *UpdateMetadata_Response) Reset() {
+	*x = UpdateMetadata_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateMetadata_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMetadata_Response) ProtoMessage() {}
+
+func (x *UpdateMetadata_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMetadata_Response.ProtoReflect.Descriptor instead.
+func (*UpdateMetadata_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *UpdateMetadata_Response) GetLink() *Link {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type RemoveContext_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The context IDs to remove, which must have been advertised and not
+	// removed. Mutually exclusive with prefix. At most 10000 context IDs are
+	// removed by a call.
+	ContextIds [][]byte `protobuf:"bytes,1,rep,name=context_ids,json=contextIds,proto3" json:"context_ids,omitempty"`
+	// Remove all the live context IDs that start with this prefix, which must
+	// not be empty. The removal fails with FAILED_PRECONDITION if more than
+	// 10000 live context IDs have the prefix.
+	Prefix []byte `protobuf:"bytes,2,opt,name=prefix,proto3,oneof" json:"prefix,omitempty"`
+	// When set, the removal fails with FAILED_PRECONDITION unless the chain
+	// head is this link.
+	ExpectedPrevious *Link `protobuf:"bytes,3,opt,name=expected_previous,json=expectedPrevious,proto3,oneof" json:"expected_previous,omitempty"`
+}
+
+func (x *RemoveContext_Request) Reset() {
+	*x = RemoveContext_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveContext_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveContext_Request) ProtoMessage() {}
+
+func (x *RemoveContext_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveContext_Request.ProtoReflect.Descriptor instead.
+func (*RemoveContext_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *RemoveContext_Request) GetContextIds() [][]byte {
+	if x != nil {
+		return x.ContextIds
+	}
+	return nil
+}
+
+func (x *RemoveContext_Request) GetPrefix() []byte {
+	if x != nil {
+		return x.Prefix
+	}
+	return nil
+}
+
+func (x *RemoveContext_Request) GetExpectedPrevious() *Link {
+	if x != nil {
+		return x.ExpectedPrevious
+	}
+	return nil
+}
+
+type RemoveContext_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The state of the removed context IDs, in the order removed.
+	Contexts []*ContextState `protobuf:"bytes,1,rep,name=contexts,proto3" json:"contexts,omitempty"`
+}
+
+func (x *RemoveContext_Response) Reset() {
+	*x = RemoveContext_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveContext_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveContext_Response) ProtoMessage() {}
+
+func (x *RemoveContext_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveContext_Response.ProtoReflect.Descriptor instead.
+func (*RemoveContext_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{13, 1}
+}
+
+func (x *RemoveContext_Response) GetContexts() []*ContextState {
+	if x != nil {
+		return x.Contexts
+	}
+	return nil
+}
+
+type GetHead_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetHead_Request) Reset() {
+	*x = GetHead_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHead_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHead_Request) ProtoMessage() {}
+
+func (x *GetHead_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHead_Request.ProtoReflect.Descriptor instead.
+func (*GetHead_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{14, 0}
+}
+
+type GetHead_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (x *GetHead_Response) Reset() {
+	*x = GetHead_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHead_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHead_Response) ProtoMessage() {}
+
+func (x *GetHead_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHead_Response.ProtoReflect.Descriptor instead.
+func (*GetHead_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{14, 1}
+}
+
+func (x *GetHead_Response) GetLink() *Link {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type GetAdvertisement_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (x *GetAdvertisement_Request) Reset() {
+	*x = GetAdvertisement_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAdvertisement_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdvertisement_Request) ProtoMessage() {}
+
+func (x *GetAdvertisement_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdvertisement_Request.ProtoReflect.Descriptor instead.
+func (*GetAdvertisement_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *GetAdvertisement_Request) GetLink() *Link {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type GetAdvertisement_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Advertisement *Advertisement `protobuf:"bytes,1,opt,name=advertisement,proto3" json:"advertisement,omitempty"`
+}
+
+func (x *GetAdvertisement_Response) Reset() {
+	*x = GetAdvertisement_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAdvertisement_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdvertisement_Response) ProtoMessage() {}
+
+func (x *GetAdvertisement_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdvertisement_Response.ProtoReflect.Descriptor instead.
+func (*GetAdvertisement_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{15, 1}
+}
+
+func (x *GetAdvertisement_Response) GetAdvertisement() *Advertisement {
+	if x != nil {
+		return x.Advertisement
+	}
+	return nil
+}
+
+type ListAdvertisements_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The link to start walking the chain from. Defaults to the chain head.
+	Start *Link `protobuf:"bytes,1,opt,name=start,proto3,oneof" json:"start,omitempty"`
+	// The maximum number of advertisements to return. Zero means no limit.
+	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The link at which to stop walking the chain, exclusive.
+	StopAt *Link `protobuf:"bytes,3,opt,name=stop_at,json=stopAt,proto3,oneof" json:"stop_at,omitempty"`
+	// Only return advertisements with this context ID.
+	ContextId []byte `protobuf:"bytes,4,opt,name=context_id,json=contextId,proto3,oneof" json:"context_id,omitempty"`
+}
+
+func (x *ListAdvertisements_Request) Reset() {
+	*x = ListAdvertisements_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAdvertisements_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdvertisements_Request) ProtoMessage() {}
+
+func (x *ListAdvertisements_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdvertisements_Request.ProtoReflect.Descriptor instead.
+func (*ListAdvertisements_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *ListAdvertisements_Request) GetStart() *Link {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ListAdvertisements_Request) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAdvertisements_Request) GetStopAt() *Link {
+	if x != nil {
+		return x.StopAt
+	}
+	return nil
+}
+
+func (x *ListAdvertisements_Request) GetContextId() []byte {
+	if x != nil {
+		return x.ContextId
+	}
+	return nil
+}
+
+type ListAdvertisements_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Advertisement *Advertisement `protobuf:"bytes,1,opt,name=advertisement,proto3" json:"advertisement,omitempty"`
+}
+
+func (x *ListAdvertisements_Response) Reset() {
+	*x = ListAdvertisements_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAdvertisements_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdvertisements_Response) ProtoMessage() {}
+
+func (x *ListAdvertisements_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdvertisements_Response.ProtoReflect.Descriptor instead.
+func (*ListAdvertisements_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{16, 1}
+}
+
+func (x *ListAdvertisements_Response) GetAdvertisement() *Advertisement {
+	if x != nil {
+		return x.Advertisement
+	}
+	return nil
+}
+
+type GetEntries_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (x *GetEntries_Request) Reset() {
+	*x = GetEntries_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEntries_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntries_Request) ProtoMessage() {}
+
+func (x *GetEntries_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntries_Request.ProtoReflect.Descriptor instead.
+func (*GetEntries_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *GetEntries_Request) GetLink() *Link {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type GetEntries_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Multihash *Multihash `protobuf:"bytes,1,opt,name=multihash,proto3" json:"multihash,omitempty"`
+}
+
+func (x *GetEntries_Response) Reset() {
+	*x = GetEntries_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEntries_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntries_Response) ProtoMessage() {}
+
+func (x *GetEntries_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntries_Response.ProtoReflect.Descriptor instead.
+func (*GetEntries_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{17, 1}
+}
+
+func (x *GetEntries_Response) GetMultihash() *Multihash {
+	if x != nil {
+		return x.Multihash
+	}
+	return nil
+}
+
+type GetContext_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContextId []byte `protobuf:"bytes,1,opt,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
+}
+
+func (x *GetContext_Request) Reset() {
+	*x = GetContext_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContext_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContext_Request) ProtoMessage() {}
+
+func (x *GetContext_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContext_Request.ProtoReflect.Descriptor instead.
+func (*GetContext_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *GetContext_Request) GetContextId() []byte {
+	if x != nil {
+		return x.ContextId
+	}
+	return nil
+}
+
+type GetContext_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *ContextState `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *GetContext_Response) Reset() {
+	*x = GetContext_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContext_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContext_Response) ProtoMessage() {}
+
+func (x *GetContext_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContext_Response.ProtoReflect.Descriptor instead.
+func (*GetContext_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{18, 1}
+}
+
+func (x *GetContext_Response) GetContext() *ContextState {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type ListContexts_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only return context IDs that start with this prefix.
+	Prefix         []byte `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	IncludeRemoved bool   `protobuf:"varint,2,opt,name=include_removed,json=includeRemoved,proto3" json:"include_removed,omitempty"`
+}
+
+func (x *ListContexts_Request) Reset() {
+	*x = ListContexts_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContexts_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContexts_Request) ProtoMessage() {}
+
+func (x *ListContexts_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContexts_Request.ProtoReflect.Descriptor instead.
+func (*ListContexts_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *ListContexts_Request) GetPrefix() []byte {
+	if x != nil {
+		return x.Prefix
+	}
+	return nil
+}
+
+func (x *ListContexts_Request) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+type ListContexts_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Context *ContextState `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *ListContexts_Response) Reset() {
+	*x = ListContexts_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContexts_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContexts_Response) ProtoMessage() {}
+
+func (x *ListContexts_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContexts_Response.ProtoReflect.Descriptor instead.
+func (*ListContexts_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{19, 1}
+}
+
+func (x *ListContexts_Response) GetContext() *ContextState {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type CollectGarbage_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Report what would be collected without deleting anything.
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *CollectGarbage_Request) Reset() {
+	*x = CollectGarbage_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectGarbage_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectGarbage_Request) ProtoMessage() {}
+
+func (x *CollectGarbage_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectGarbage_Request.ProtoReflect.Descriptor instead.
+func (*CollectGarbage_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *CollectGarbage_Request) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type CollectGarbage_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of blocks reachable from the advertisement chain head or
+	// from content sessions.
+	Reachable uint64 `protobuf:"varint,1,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	// The number of unreachable blocks kept, since they have not been
+	// unreachable for the grace period.
+	Unreachable uint64 `protobuf:"varint,2,opt,name=unreachable,proto3" json:"unreachable,omitempty"`
+	// The number of unreachable blocks deleted, or that would be in a dry run.
+	Swept uint64 `protobuf:"varint,3,opt,name=swept,proto3" json:"swept,omitempty"`
+	// The total size in bytes of the blocks swept.
+	SweptBytes uint64 `protobuf:"varint,4,opt,name=swept_bytes,json=sweptBytes,proto3" json:"swept_bytes,omitempty"`
+}
+
+func (x *CollectGarbage_Response) Reset() {
+	*x = CollectGarbage_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectGarbage_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectGarbage_Response) ProtoMessage() {}
+
+func (x *CollectGarbage_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectGarbage_Response.ProtoReflect.Descriptor instead.
+func (*CollectGarbage_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{20, 1}
+}
+
+func (x *CollectGarbage_Response) GetReachable() uint64 {
+	if x != nil {
+		return x.Reachable
+	}
+	return 0
+}
+
+func (x *CollectGarbage_Response) GetUnreachable() uint64 {
+	if x != nil {
+		return x.Unreachable
+	}
+	return 0
+}
+
+func (x *CollectGarbage_Response) GetSwept() uint64 {
+	if x != nil {
+		return x.Swept
+	}
+	return 0
+}
+
+func (x *CollectGarbage_Response) GetSweptBytes() uint64 {
+	if x != nil {
+		return x.SweptBytes
+	}
+	return 0
+}
+
+type Export_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Exclude the entries of advertisements.
+	ExcludeEntries bool `protobuf:"varint,1,opt,name=exclude_entries,json=excludeEntries,proto3" json:"exclude_entries,omitempty"`
+	// The advertisement at which to stop, exclusive. If unset, the whole
+	// chain is exported.
+	StopAt *Link `protobuf:"bytes,2,opt,name=stop_at,json=stopAt,proto3,oneof" json:"stop_at,omitempty"`
+}
+
+func (x *Export_Request) Reset() {
+	*x = Export_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Export_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Export_Request) ProtoMessage() {}
+
+func (x *Export_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Export_Request.ProtoReflect.Descriptor instead.
+func (*Export_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *Export_Request) GetExcludeEntries() bool {
+	if x != nil {
+		return x.ExcludeEntries
+	}
+	return false
+}
+
+func (x *Export_Request) GetStopAt() *Link {
+	if x != nil {
+		return x.StopAt
+	}
+	return nil
+}
+
+type Export_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The next chunk of the CARv2 file, rooted at the chain head.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *Export_Response) Reset() {
+	*x = Export_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Export_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Export_Response) ProtoMessage() {}
+
+func (x *Export_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Export_Response.ProtoReflect.Descriptor instead.
+func (*Export_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{21, 1}
+}
+
+func (x *Export_Response) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Import_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The next chunk of a CAR file rooted at the advertisement chain head to
+	// import.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Replace the chain head even if the imported chain does not extend it,
+	// or lacks the advertisements older than some advertisement. Only read
+	// from the first request.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *Import_Request) Reset() {
+	*x = Import_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Import_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Import_Request) ProtoMessage() {}
+
+func (x *Import_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Import_Request.ProtoReflect.Descriptor instead.
+func (*Import_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *Import_Request) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Import_Request) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type Import_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The link to the imported chain head.
+	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	// The number of advertisements in the imported chain.
+	Advertisements uint64 `protobuf:"varint,2,opt,name=advertisements,proto3" json:"advertisements,omitempty"`
+	// The number of blocks imported.
+	Blocks uint64 `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (x *Import_Response) Reset() {
+	*x = Import_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Import_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Import_Response) ProtoMessage() {}
+
+func (x *Import_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Import_Response.ProtoReflect.Descriptor instead.
+func (*Import_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{22, 1}
+}
+
+func (x *Import_Response) GetLink() *Link {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *Import_Response) GetAdvertisements() uint64 {
+	if x != nil {
+		return x.Advertisements
+	}
+	return 0
+}
+
+func (x *Import_Response) GetBlocks() uint64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+type Reannounce_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Reannounce_Request) Reset() {
+	*x = Reannounce_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reannounce_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reannounce_Request) ProtoMessage() {}
+
+func (x *Reannounce_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reannounce_Request.ProtoReflect.Descriptor instead.
+func (*Reannounce_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{23, 0}
+}
+
+type Reannounce_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The link to the advertisement chain head announced.
+	Link *Link `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (x *Reannounce_Response) Reset() {
+	*x = Reannounce_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reannounce_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reannounce_Response) ProtoMessage() {}
+
+func (x *Reannounce_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reannounce_Response.ProtoReflect.Descriptor instead.
+func (*Reannounce_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{23, 1}
+}
+
+func (x *Reannounce_Response) GetLink() *Link {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type AnnounceStatus_Sender struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The sender name, i.e. pubsub or the URL of the indexer announced to
+	// over HTTP.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The link to the advertisement of the latest delivery attempt.
+	Link *Link `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	// The times of the latest delivery attempt and success, in seconds since
+	// the Unix epoch.
+	LastAttempt int64 `protobuf:"varint,3,opt,name=last_attempt,json=lastAttempt,proto3" json:"last_attempt,omitempty"`
+	LastSuccess int64 `protobuf:"varint,4,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`
+	// The error of the latest delivery attempt, empty if it succeeded.
+	LastError string `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// The number of consecutive failed delivery attempts.
+	Failures uint64 `protobuf:"varint,6,opt,name=failures,proto3" json:"failures,omitempty"`
+	// Whether a failed delivery is being retried, and the time of the next
+	// attempt in seconds since the Unix epoch.
+	Retrying  bool  `protobuf:"varint,7,opt,name=retrying,proto3" json:"retrying,omitempty"`
+	NextRetry int64 `protobuf:"varint,8,opt,name=next_retry,json=nextRetry,proto3" json:"next_retry,omitempty"`
+}
+
+func (x *AnnounceStatus_Sender) Reset() {
+	*x = AnnounceStatus_Sender{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnounceStatus_Sender) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnounceStatus_Sender) ProtoMessage() {}
+
+func (x *AnnounceStatus_Sender) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnounceStatus_Sender.ProtoReflect.Descriptor instead.
+func (*AnnounceStatus_Sender) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *AnnounceStatus_Sender) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AnnounceStatus_Sender) GetLink() *Link {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *AnnounceStatus_Sender) GetLastAttempt() int64 {
+	if x != nil {
+		return x.LastAttempt
+	}
+	return 0
+}
+
+func (x *AnnounceStatus_Sender) GetLastSuccess() int64 {
+	if x != nil {
+		return x.LastSuccess
+	}
+	return 0
+}
+
+func (x *AnnounceStatus_Sender) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *AnnounceStatus_Sender) GetFailures() uint64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *AnnounceStatus_Sender) GetRetrying() bool {
+	if x != nil {
+		return x.Retrying
+	}
+	return false
+}
+
+func (x *AnnounceStatus_Sender) GetNextRetry() int64 {
+	if x != nil {
+		return x.NextRetry
+	}
+	return 0
+}
+
+type AnnounceStatus_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AnnounceStatus_Request) Reset() {
+	*x = AnnounceStatus_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnounceStatus_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnounceStatus_Request) ProtoMessage() {}
+
+func (x *AnnounceStatus_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnounceStatus_Request.ProtoReflect.Descriptor instead.
+func (*AnnounceStatus_Request) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{24, 1}
+}
+
+type AnnounceStatus_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Senders []*AnnounceStatus_Sender `protobuf:"bytes,1,rep,name=senders,proto3" json:"senders,omitempty"`
+}
+
+func (x *AnnounceStatus_Response) Reset() {
+	*x = AnnounceStatus_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_depute_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnnounceStatus_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnounceStatus_Response) ProtoMessage() {}
+
+func (x *AnnounceStatus_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_depute_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnounceStatus_Response.ProtoReflect.Descriptor instead.
+func (*AnnounceStatus_Response) Descriptor() ([]byte, []int) {
+	return file_depute_proto_rawDescGZIP(), []int{24, 2}
+}
+
+func (x *AnnounceStatus_Response) GetSenders() []*AnnounceStatus_Sender {
+	if x != nil {
+		return x.Senders
+	}
+	return nil
+}
+
+var File_depute_proto protoreflect.FileDescriptor
+
+var file_depute_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e,
+	0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x22, 0x1c,
+	0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x21, 0x0a, 0x09,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xfb, 0x03, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3f, 0x0a, 0x09,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30,
+	0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x73, 0x1a, 0x09, 0x0a,
+	0x07, 0x42, 0x69, 0x74, 0x73, 0x77, 0x61, 0x70, 0x1a, 0x7e, 0x0a, 0x13, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x73, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x56, 0x31, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x70, 0x69, 0x65, 0x63, 0x65, 0x43, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x44, 0x65, 0x61,
+	0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x1a, 0x11, 0x0a, 0x0f, 0x49, 0x70, 0x66, 0x73,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x48, 0x74, 0x74, 0x70, 0x1a, 0x8f, 0x02, 0x0a, 0x08,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x3c, 0x0a, 0x07, 0x62, 0x69, 0x74, 0x73,
+	0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x70, 0x6e, 0x69,
+	0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x42, 0x69, 0x74, 0x73, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x07, 0x62,
+	0x69, 0x74, 0x73, 0x77, 0x61, 0x70, 0x12, 0x61, 0x0a, 0x14, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73,
+	0x79, 0x6e, 0x63, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x76, 0x31, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x73, 0x79, 0x6e, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e,
+	0x56, 0x31, 0x48, 0x00, 0x52, 0x13, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x79, 0x6e, 0x63, 0x46,
+	0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x76, 0x31, 0x12, 0x56, 0x0a, 0x11, 0x69, 0x70, 0x66,
+	0x73, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49,
+	0x70, 0x66, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x48, 0x74, 0x74, 0x70, 0x48, 0x00,
+	0x52, 0x0f, 0x69, 0x70, 0x66, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x48, 0x74, 0x74,
+	0x70, 0x42, 0x0a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0xbc, 0x03,
+	0x0a, 0x0d, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x24, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70,
+	0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e,
+	0x6b, 0x52, 0x02, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65,
+	0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x01,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x48, 0x02, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70,
+	0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x08, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x70,
+	0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x74, 0x79, 0x70, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xe0, 0x01, 0x0a,
+	0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0d,
+	0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x0d, 0x61, 0x64, 0x76, 0x65, 0x72,
+	0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69,
+	0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x48,
+	0x00, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x8d, 0x03, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x1a, 0x85, 0x01, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x1a, 0x83, 0x01, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e,
+	0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x68,
+	0x61, 0x73, 0x68, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x61, 0x73, 0x68, 0x12, 0x3f,
+	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x6e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6c,
+	0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69,
+	0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
+	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22,
+	0x8f, 0x02, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x1a, 0x78, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x73, 0x1a, 0x7e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70,
+	0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x04, 0x6c, 0x69, 0x6e,
+	0x6b, 0x22, 0xa9, 0x04, 0x0a, 0x09, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x1a,
+	0xec, 0x01, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0d, 0x61, 0x64,
+	0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e,
+	0x76, 0x30, 0x2e, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0d, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x3f, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76,
+	0x30, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70,
+	0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e,
+	0x6b, 0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x1a, 0x8b,
+	0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x70, 0x6e,
+	0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x41, 0x64, 0x76, 0x65,
+	0x72, 0x74, 0x69, 0x73, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x70, 0x6e, 0x69,
+	0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x68, 0x61, 0x73, 0x68, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x61, 0x73,
+	0x68, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x9e, 0x01, 0x0a,
+	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x04, 0x6c,
+	0x69, 0x6e, 0x6b, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0xa1, 0x02,
+	0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x3f, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e,
+	0x76, 0x30, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
+	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52,
+	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x69, 0x6e,
+	0x6b, 0x22, 0x7f, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x1a, 0x28, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x1a, 0x44, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x70, 0x6e, 0x69,
+	0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x93, 0x02, 0x0a, 0x07, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x1a, 0xd1,
+	0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x61, 0x64,
+	0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e,
+	0x76, 0x30, 0x2e, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0d, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x46, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e,
+	0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x5f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x73, 0x6b, 0x69, 0x70, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x42, 0x14, 0x0a, 0x12,
+	0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x1a, 0x34, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69,
+	0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x9c, 0x02, 0x0a, 0x0c, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x1a, 0xd3, 0x01, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x0e, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69,
+	0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x41,
+	0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x61, 0x64,
+	0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x11,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52,
+	0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x6b, 0x69,
+	0x70, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x1a,
+	0x36, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x6c,
+	0x69, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e,
+	0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x22, 0xac, 0x02, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0xe3, 0x01, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x3f, 0x0a, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x70, 0x6e, 0x69,
+	0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x0d, 0x74, 0x79, 0x70, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c,
+	0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x1a, 0x34, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04,
+	0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e,
+	0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x88, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x1a, 0xb0, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x88,
+	0x01, 0x01, 0x12, 0x46, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c,
+	0x69, 0x6e, 0x6b, 0x48, 0x01, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x1a, 0x44, 0x0a, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x70, 0x6e, 0x69,
+	0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x73, 0x22, 0x4a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x1a, 0x09, 0x0a, 0x07,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e,
+	0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x98, 0x01,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x1a, 0x33, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70,
+	0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e,
+	0x6b, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x1a, 0x4f, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x70, 0x6e,
+	0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x41, 0x64, 0x76, 0x65,
+	0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x61, 0x64, 0x76, 0x65, 0x72,
+	0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xb5, 0x02, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a,
+	0xcd, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e,
+	0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x01, 0x52, 0x06, 0x73, 0x74, 0x6f,
+	0x70, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x02, 0x52, 0x09, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x61, 0x74,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x1a,
+	0x4f, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x61,
+	0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65,
+	0x2e, 0x76, 0x30, 0x2e, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0d, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x86, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a,
+	0x33, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x6c, 0x69,
+	0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e,
+	0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x04,
+	0x6c, 0x69, 0x6e, 0x6b, 0x1a, 0x43, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x37, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x61, 0x73, 0x68, 0x52, 0x09,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x61, 0x73, 0x68, 0x22, 0x7a, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x1a, 0x28, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x49,
+	0x64, 0x1a, 0x42, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x1a, 0x4a, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x1a, 0x42, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x1a, 0x22, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x1a, 0x81, 0x01,
+	0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
+	0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x72, 0x65,
+	0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75,
+	0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x77,
+	0x65, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x77, 0x65, 0x70, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x77, 0x65, 0x70, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x77, 0x65, 0x70, 0x74, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x22, 0x9c, 0x01, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x72, 0x0a, 0x07,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x32, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e,
+	0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x70, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x61, 0x74,
+	0x1a, 0x1e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0xb3, 0x01, 0x0a, 0x06, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x33, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x1a, 0x74, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04,
+	0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e,
+	0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74,
+	0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x4d, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x6e, 0x6e, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x34, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6c,
+	0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69,
+	0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
+	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xed, 0x02, 0x0a, 0x0e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x82, 0x02, 0x0a, 0x06, 0x53, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70,
+	0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x04, 0x6c, 0x69, 0x6e,
+	0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x72, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x74, 0x72, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x1a, 0x09, 0x0a,
+	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x4b, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70,
+	0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x07, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x73, 0x32, 0xb8, 0x0a, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x65, 0x72, 0x12, 0x60, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x70,
+	0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x6e, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x25, 0x2e, 0x69,
+	0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x54, 0x0a, 0x09, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69,
+	0x73, 0x65, 0x12, 0x21, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65,
+	0x2e, 0x76, 0x30, 0x2e, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70,
+	0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x5e, 0x0a, 0x0d, 0x52,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x69,
+	0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x52, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x2e, 0x76, 0x30, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x07, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x1f, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65,
+	0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x24, 0x2e, 0x69, 0x70, 0x6e, 0x69,
+	0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x26, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e,
+	0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76,
+	0x30, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0d, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x25, 0x2e, 0x69, 0x70, 0x6e,
+	0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e,
+	0x76, 0x30, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x07, 0x47, 0x65, 0x74,
+	0x48, 0x65, 0x61, 0x64, 0x12, 0x1f, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70,
+	0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x64,
+	0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x69, 0x70,
+	0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70,
+	0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74,
+	0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6f, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65,
+	0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x76, 0x65,
+	0x72, 0x74, 0x69, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65,
+	0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
+	0x01, 0x12, 0x57, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x22, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74,
+	0x65, 0x2e, 0x76, 0x30, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x55, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x22, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e,
+	0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69,
+	0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x73, 0x12, 0x24, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e,
+	0x76, 0x30, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
+	0x32, 0xbe, 0x03, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x61, 0x0a, 0x0e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x12, 0x26, 0x2e, 0x69,
+	0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x47, 0x61, 0x72,
+	0x62, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a,
+	0x06, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64,
+	0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x06, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75,
+	0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x55, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70,
+	0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x52, 0x65, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x69, 0x70, 0x6e, 0x69,
+	0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x52, 0x65, 0x61, 0x6e, 0x6e,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61,
+	0x0a, 0x0e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x26, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76,
+	0x30, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x70, 0x6e, 0x69, 0x2e,
+	0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2e, 0x76, 0x30, 0x2e, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x69, 0x70, 0x6e, 0x69, 0x2f, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x30, 0x3b, 0x64, 0x65, 0x70, 0x75, 0x74, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_depute_proto_rawDescOnce sync.Once
+	file_depute_proto_rawDescData = file_depute_proto_rawDesc
+)
+
+func file_depute_proto_rawDescGZIP() []byte {
+	file_depute_proto_rawDescOnce.Do(func() {
+		file_depute_proto_rawDescData = protoimpl.X.CompressGZIP(file_depute_proto_rawDescData)
+	})
+	return file_depute_proto_rawDescData
+}
+
+var file_depute_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
+var file_depute_proto_goTypes = []interface{}{
+	(*Link)(nil),                         // 0: ipni.depute.v0.Link
+	(*Multihash)(nil),                    // 1: ipni.depute.v0.Multihash
+	(*Metadata)(nil),                     // 2: ipni.depute.v0.Metadata
+	(*Advertisement)(nil),                // 3: ipni.depute.v0.Advertisement
+	(*ContextState)(nil),                 // 4: ipni.depute.v0.ContextState
+	(*NotifyContent)(nil),                // 5: ipni.depute.v0.NotifyContent
+	(*NotifyContentStream)(nil),          // 6: ipni.depute.v0.NotifyContentStream
+	(*Advertise)(nil),                    // 7: ipni.depute.v0.Advertise
+	(*ContentSession)(nil),               // 8: ipni.depute.v0.ContentSession
+	(*ResumeContent)(nil),                // 9: ipni.depute.v0.ResumeContent
+	(*Publish)(nil),                      // 10: ipni.depute.v0.Publish
+	(*BatchPublish)(nil),                 // 11: ipni.depute.v0.BatchPublish
+	(*UpdateMetadata)(nil),               // 12: ipni.depute.v0.UpdateMetadata
+	(*RemoveContext)(nil),                // 13: ipni.depute.v0.RemoveContext
+	(*GetHead)(nil),                      // 14: ipni.depute.v0.GetHead
+	(*GetAdvertisement)(nil),             // 15: ipni.depute.v0.GetAdvertisement
+	(*ListAdvertisements)(nil),           // 16: ipni.depute.v0.ListAdvertisements
+	(*GetEntries)(nil),                   // 17: ipni.depute.v0.GetEntries
+	(*GetContext)(nil),                   // 18: ipni.depute.v0.GetContext
+	(*ListContexts)(nil),                 // 19: ipni.depute.v0.ListContexts
+	(*CollectGarbage)(nil),               // 20: ipni.depute.v0.CollectGarbage
+	(*Export)(nil),                       // 21: ipni.depute.v0.Export
+	(*Import)(nil),                       // 22: ipni.depute.v0.Import
+	(*Reannounce)(nil),                   // 23: ipni.depute.v0.Reannounce
+	(*AnnounceStatus)(nil),               // 24: ipni.depute.v0.AnnounceStatus
+	(*Metadata_Bitswap)(nil),             // 25: ipni.depute.v0.Metadata.Bitswap
+	(*Metadata_GraphsyncFilecoinV1)(nil), // 26: ipni.depute.v0.Metadata.GraphsyncFilecoinV1
+	(*Metadata_IpfsGatewayHttp)(nil),     // 27: ipni.depute.v0.Metadata.IpfsGatewayHttp
+	(*Metadata_Protocol)(nil),            // 28: ipni.depute.v0.Metadata.Protocol
+	(*NotifyContent_Options)(nil),        // 29: ipni.depute.v0.NotifyContent.Options
+	(*NotifyContent_Request)(nil),        // 30: ipni.depute.v0.NotifyContent.Request
+	(*NotifyContent_Response)(nil),       // 31: ipni.depute.v0.NotifyContent.Response
+	(*NotifyContentStream_Progress)(nil), // 32: ipni.depute.v0.NotifyContentStream.Progress
+	(*NotifyContentStream_Response)(nil), // 33: ipni.depute.v0.NotifyContentStream.Response
+	(*Advertise_Header)(nil),             // 34: ipni.depute.v0.Advertise.Header
+	(*Advertise_Request)(nil),            // 35: ipni.depute.v0.Advertise.Request
+	(*Advertise_Response)(nil),           // 36: ipni.depute.v0.Advertise.Response
+	(*ResumeContent_Request)(nil),        // 37: ipni.depute.v0.ResumeContent.Request
+	(*ResumeContent_Response)(nil),       // 38: ipni.depute.v0.ResumeContent.Response
+	(*Publish_Request)(nil),              // 39: ipni.depute.v0.Publish.Request
+	(*Publish_Response)(nil),             // 40: ipni.depute.v0.Publish.Response
+	(*BatchPublish_Request)(nil),         // 41: ipni.depute.v0.BatchPublish.Request
+	(*BatchPublish_Response)(nil),        // 42: ipni.depute.v0.BatchPublish.Response
+	(*UpdateMetadata_Request)(nil),       // 43: ipni.depute.v0.UpdateMetadata.Request
+	(*UpdateMetadata_Response)(nil),      // 44: ipni.depute.v0.UpdateMetadata.Response
+	(*RemoveContext_Request)(nil),        // 45: ipni.depute.v0.RemoveContext.Request
+	(*RemoveContext_Response)(nil),       // 46: ipni.depute.v0.RemoveContext.Response
+	(*GetHead_Request)(nil),              // 47: ipni.depute.v0.GetHead.Request
+	(*GetHead_Response)(nil),             // 48: ipni.depute.v0.GetHead.Response
+	(*GetAdvertisement_Request)(nil),     // 49: ipni.depute.v0.GetAdvertisement.Request
+	(*GetAdvertisement_Response)(nil),    // 50: ipni.depute.v0.GetAdvertisement.Response
+	(*ListAdvertisements_Request)(nil),   // 51: ipni.depute.v0.ListAdvertisements.Request
+	(*ListAdvertisements_Response)(nil),  // 52: ipni.depute.v0.ListAdvertisements.Response
+	(*GetEntries_Request)(nil),           // 53: ipni.depute.v0.GetEntries.Request
+	(*GetEntries_Response)(nil),          // 54: ipni.depute.v0.GetEntries.Response
+	(*GetContext_Request)(nil),           // 55: ipni.depute.v0.GetContext.Request
+	(*GetContext_Response)(nil),          // 56: ipni.depute.v0.GetContext.Response
+	(*ListContexts_Request)(nil),         // 57: ipni.depute.v0.ListContexts.Request
+	(*ListContexts_Response)(nil),        // 58: ipni.depute.v0.ListContexts.Response
+	(*CollectGarbage_Request)(nil),       // 59: ipni.depute.v0.CollectGarbage.Request
+	(*CollectGarbage_Response)(nil),      // 60: ipni.depute.v0.CollectGarbage.Response
+	(*Export_Request)(nil),               // 61: ipni.depute.v0.Export.Request
+	(*Export_Response)(nil),              // 62: ipni.depute.v0.Export.Response
+	(*Import_Request)(nil),               // 63: ipni.depute.v0.Import.Request
+	(*Import_Response)(nil),              // 64: ipni.depute.v0.Import.Response
+	(*Reannounce_Request)(nil),           // 65: ipni.depute.v0.Reannounce.Request
+	(*Reannounce_Response)(nil),          // 66: ipni.depute.v0.Reannounce.Response
+	(*AnnounceStatus_Sender)(nil),        // 67: ipni.depute.v0.AnnounceStatus.Sender
+	(*AnnounceStatus_Request)(nil),       // 68: ipni.depute.v0.AnnounceStatus.Request
+	(*AnnounceStatus_Response)(nil),      // 69: ipni.depute.v0.AnnounceStatus.Response
+}
+var file_depute_proto_depIdxs = []int32{
+	28, // 0: ipni.depute.v0.Metadata.protocols:type_name -> ipni.depute.v0.Metadata.Protocol
+	0,  // 1: ipni.depute.v0.Advertisement.ID:type_name -> ipni.depute.v0.Link
+	0,  // 2: ipni.depute.v0.Advertisement.entries:type_name -> ipni.depute.v0.Link
+	0,  // 3: ipni.depute.v0.Advertisement.previous:type_name -> ipni.depute.v0.Link
+	2,  // 4: ipni.depute.v0.Advertisement.typed_metadata:type_name -> ipni.depute.v0.Metadata
+	0,  // 5: ipni.depute.v0.ContextState.advertisement:type_name -> ipni.depute.v0.Link
+	0,  // 6: ipni.depute.v0.ContextState.entries:type_name -> ipni.depute.v0.Link
+	29, // 7: ipni.depute.v0.ContentSession.options:type_name -> ipni.depute.v0.NotifyContent.Options
+	0,  // 8: ipni.depute.v0.ContentSession.link:type_name -> ipni.depute.v0.Link
+	25, // 9: ipni.depute.v0.Metadata.Protocol.bitswap:type_name -> ipni.depute.v0.Metadata.Bitswap
+	26, // 10: ipni.depute.v0.Metadata.Protocol.graphsync_filecoinv1:type_name -> ipni.depute.v0.Metadata.GraphsyncFilecoinV1
+	27, // 11: ipni.depute.v0.Metadata.Protocol.ipfs_gateway_http:type_name -> ipni.depute.v0.Metadata.IpfsGatewayHttp
+	1,  // 12: ipni.depute.v0.NotifyContent.Request.multihash:type_name -> ipni.depute.v0.Multihash
+	29, // 13: ipni.depute.v0.NotifyContent.Request.options:type_name -> ipni.depute.v0.NotifyContent.Options
+	0,  // 14: ipni.depute.v0.NotifyContent.Response.link:type_name -> ipni.depute.v0.Link
+	32, // 15: ipni.depute.v0.NotifyContentStream.Response.progress:type_name -> ipni.depute.v0.NotifyContentStream.Progress
+	0,  // 16: ipni.depute.v0.NotifyContentStream.Response.link:type_name -> ipni.depute.v0.Link
+	3,  // 17: ipni.depute.v0.Advertise.Header.advertisement:type_name -> ipni.depute.v0.Advertisement
+	29, // 18: ipni.depute.v0.Advertise.Header.options:type_name -> ipni.depute.v0.NotifyContent.Options
+	0,  // 19: ipni.depute.v0.Advertise.Header.expected_previous:type_name -> ipni.depute.v0.Link
+	34, // 20: ipni.depute.v0.Advertise.Request.header:type_name -> ipni.depute.v0.Advertise.Header
+	1,  // 21: ipni.depute.v0.Advertise.Request.multihash:type_name -> ipni.depute.v0.Multihash
+	0,  // 22: ipni.depute.v0.Advertise.Response.link:type_name -> ipni.depute.v0.Link
+	0,  // 23: ipni.depute.v0.Advertise.Response.entries:type_name -> ipni.depute.v0.Link
+	8,  // 24: ipni.depute.v0.ResumeContent.Response.session:type_name -> ipni.depute.v0.ContentSession
+	3,  // 25: ipni.depute.v0.Publish.Request.advertisement:type_name -> ipni.depute.v0.Advertisement
+	0,  // 26: ipni.depute.v0.Publish.Request.expected_previous:type_name -> ipni.depute.v0.Link
+	0,  // 27: ipni.depute.v0.Publish.Response.link:type_name -> ipni.depute.v0.Link
+	3,  // 28: ipni.depute.v0.BatchPublish.Request.advertisements:type_name -> ipni.depute.v0.Advertisement
+	0,  // 29: ipni.depute.v0.BatchPublish.Request.expected_previous:type_name -> ipni.depute.v0.Link
+	0,  // 30: ipni.depute.v0.BatchPublish.Response.links:type_name -> ipni.depute.v0.Link
+	2,  // 31: ipni.depute.v0.UpdateMetadata.Request.typed_metadata:type_name -> ipni.depute.v0.Metadata
+	0,  // 32: ipni.depute.v0.UpdateMetadata.Request.expected_previous:type_name -> ipni.depute.v0.Link
+	0,  // 33: ipni.depute.v0.UpdateMetadata.Response.link:type_name -> ipni.depute.v0.Link
+	0,  // 34: ipni.depute.v0.RemoveContext.Request.expected_previous:type_name -> ipni.depute.v0.Link
+	4,  // 35: ipni.depute.v0.RemoveContext.Response.contexts:type_name -> ipni.depute.v0.ContextState
+	0,  // 36: ipni.depute.v0.GetHead.Response.link:type_name -> ipni.depute.v0.Link
+	0,  // 37: ipni.depute.v0.GetAdvertisement.Request.link:type_name -> ipni.depute.v0.Link
+	3,  // 38: ipni.depute.v0.GetAdvertisement.Response.advertisement:type_name -> ipni.depute.v0.Advertisement
+	0,  // 39: ipni.depute.v0.ListAdvertisements.Request.start:type_name -> ipni.depute.v0.Link
+	0,  // 40: ipni.depute.v0.ListAdvertisements.Request.stop_at:type_name -> ipni.depute.v0.Link
+	3,  // 41: ipni.depute.v0.ListAdvertisements.Response.advertisement:type_name -> ipni.depute.v0.Advertisement
+	0,  // 42: ipni.depute.v0.GetEntries.Request.link:type_name -> ipni.depute.v0.Link
+	1,  // 43: ipni.depute.v0.GetEntries.Response.multihash:type_name -> ipni.depute.v0.Multihash
+	4,  // 44: ipni.depute.v0.GetContext.Response.context:type_name -> ipni.depute.v0.ContextState
+	4,  // 45: ipni.depute.v0.ListContexts.Response.context:type_name -> ipni.depute.v0.ContextState
+	0,  // 46: ipni.depute.v0.Export.Request.stop_at:type_name -> ipni.depute.v0.Link
+	0,  // 47: ipni.depute.v0.Import.Response.link:type_name -> ipni.depute.v0.Link
+	0,  // 48: ipni.depute.v0.Reannounce.Response.link:type_name -> ipni.depute.v0.Link
+	0,  // 49: ipni.depute.v0.AnnounceStatus.Sender.link:type_name -> ipni.depute.v0.Link
+	67, // 50: ipni.depute.v0.AnnounceStatus.Response.senders:type_name -> ipni.depute.v0.AnnounceStatus.Sender
+	30, // 51: ipni.depute.v0.Publisher.NotifyContent:input_type -> ipni.depute.v0.NotifyContent.Request
+	30, // 52: ipni.depute.v0.Publisher.NotifyContentStream:input_type -> ipni.depute.v0.NotifyContent.Request
+	35, // 53: ipni.depute.v0.Publisher.Advertise:input_type -> ipni.depute.v0.Advertise.Request
+	37, // 54: ipni.depute.v0.Publisher.ResumeContent:input_type -> ipni.depute.v0.ResumeContent.Request
+	39, // 55: ipni.depute.v0.Publisher.Publish:input_type -> ipni.depute.v0.Publish.Request
+	41, // 56: ipni.depute.v0.Publisher.BatchPublish:input_type -> ipni.depute.v0.BatchPublish.Request
+	43, // 57: ipni.depute.v0.Publisher.UpdateMetadata:input_type -> ipni.depute.v0.UpdateMetadata.Request
+	45, // 58: ipni.depute.v0.Publisher.RemoveContext:input_type -> ipni.depute.v0.RemoveContext.Request
+	47, // 59: ipni.depute.v0.Publisher.GetHead:input_type -> ipni.depute.v0.GetHead.Request
+	49, // 60: ipni.depute.v0.Publisher.GetAdvertisement:input_type -> ipni.depute.v0.GetAdvertisement.Request
+	51, // 61: ipni.depute.v0.Publisher.ListAdvertisements:input_type -> ipni.depute.v0.ListAdvertisements.Request
+	53, // 62: ipni.depute.v0.Publisher.GetEntries:input_type -> ipni.depute.v0.GetEntries.Request
+	55, // 63: ipni.depute.v0.Publisher.GetContext:input_type -> ipni.depute.v0.GetContext.Request
+	57, // 64: ipni.depute.v0.Publisher.ListContexts:input_type -> ipni.depute.v0.ListContexts.Request
+	59, // 65: ipni.depute.v0.Admin.CollectGarbage:input_type -> ipni.depute.v0.CollectGarbage.Request
+	61, // 66: ipni.depute.v0.Admin.Export:input_type -> ipni.depute.v0.Export.Request
+	63, // 67: ipni.depute.v0.Admin.Import:input_type -> ipni.depute.v0.Import.Request
+	65, // 68: ipni.depute.v0.Admin.Reannounce:input_type -> ipni.depute.v0.Reannounce.Request
+	68, // 69: ipni.depute.v0.Admin.AnnounceStatus:input_type -> ipni.depute.v0.AnnounceStatus.Request
+	31, // 70: ipni.depute.v0.Publisher.NotifyContent:output_type -> ipni.depute.v0.NotifyContent.Response
+	33, // 71: ipni.depute.v0.Publisher.NotifyContentStream:output_type -> ipni.depute.v0.NotifyContentStream.Response
+	36, // 72: ipni.depute.v0.Publisher.Advertise:output_type -> ipni.depute.v0.Advertise.Response
+	38, // 73: ipni.depute.v0.Publisher.ResumeContent:output_type -> ipni.depute.v0.ResumeContent.Response
+	40, // 74: ipni.depute.v0.Publisher.Publish:output_type -> ipni.depute.v0.Publish.Response
+	42, // 75: ipni.depute.v0.Publisher.BatchPublish:output_type -> ipni.depute.v0.BatchPublish.Response
+	44, // 76: ipni.depute.v0.Publisher.UpdateMetadata:output_type -> ipni.depute.v0.UpdateMetadata.Response
+	46, // 77: ipni.depute.v0.Publisher.RemoveContext:output_type -> ipni.depute.v0.RemoveContext.Response
+	48, // 78: ipni.depute.v0.Publisher.GetHead:output_type -> ipni.depute.v0.GetHead.Response
+	50, // 79: ipni.depute.v0.Publisher.GetAdvertisement:output_type -> ipni.depute.v0.GetAdvertisement.Response
+	52, // 80: ipni.depute.v0.Publisher.ListAdvertisements:output_type -> ipni.depute.v0.ListAdvertisements.Response
+	54, // 81: ipni.depute.v0.Publisher.GetEntries:output_type -> ipni.depute.v0.GetEntries.Response
+	56, // 82: ipni.depute.v0.Publisher.GetContext:output_type -> ipni.depute.v0.GetContext.Response
+	58, // 83: ipni.depute.v0.Publisher.ListContexts:output_type -> ipni.depute.v0.ListContexts.Response
+	60, // 84: ipni.depute.v0.Admin.CollectGarbage:output_type -> ipni.depute.v0.CollectGarbage.Response
+	62, // 85: ipni.depute.v0.Admin.Export:output_type -> ipni.depute.v0.Export.Response
+	64, // 86: ipni.depute.v0.Admin.Import:output_type -> ipni.depute.v0.Import.Response
+	66, // 87: ipni.depute.v0.Admin.Reannounce:output_type -> ipni.depute.v0.Reannounce.Response
+	69, // 88: ipni.depute.v0.Admin.AnnounceStatus:output_type -> ipni.depute.v0.AnnounceStatus.Response
+	70, // [70:89] is the sub-list for method output_type
+	51, // [51:70] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
+}
+
+func init() { file_depute_proto_init() }
+func file_depute_proto_init() {
+	if File_depute_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_depute_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Link); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Multihash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Metadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Advertisement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContextState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyContent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyContentStream); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Advertise); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeContent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Publish); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchPublish); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHead); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAdvertisement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAdvertisements); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEntries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContexts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectGarbage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Export); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Import); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reannounce); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnounceStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Metadata_Bitswap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Metadata_GraphsyncFilecoinV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Metadata_IpfsGatewayHttp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Metadata_Protocol); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyContent_Options); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyContent_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyContent_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyContentStream_Progress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyContentStream_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Advertise_Header); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Advertise_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Advertise_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeContent_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeContent_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -576,8 +4950,8 @@ func file_depute_proto_init() {
 				return nil
 			}
 		}
-		file_depute_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Multihash); i {
+		file_depute_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Publish_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -588,8 +4962,8 @@ func file_depute_proto_init() {
 				return nil
 			}
 		}
-		file_depute_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Advertisement); i {
+		file_depute_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Publish_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -600,8 +4974,8 @@ func file_depute_proto_init() {
 				return nil
 			}
 		}
-		file_depute_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotifyContent); i {
+		file_depute_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchPublish_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -612,8 +4986,8 @@ func file_depute_proto_init() {
 				return nil
 			}
 		}
-		file_depute_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Publish); i {
+		file_depute_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchPublish_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -624,8 +4998,8 @@ func file_depute_proto_init() {
 				return nil
 			}
 		}
-		file_depute_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotifyContent_Request); i {
+		file_depute_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMetadata_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -636,8 +5010,8 @@ func file_depute_proto_init() {
 				return nil
 			}
 		}
-		file_depute_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotifyContent_Response); i {
+		file_depute_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateMetadata_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -648,8 +5022,8 @@ func file_depute_proto_init() {
 				return nil
 			}
 		}
-		file_depute_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Publish_Request); i {
+		file_depute_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveContext_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -660,8 +5034,248 @@ func file_depute_proto_init() {
 				return nil
 			}
 		}
-		file_depute_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Publish_Response); i {
+		file_depute_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveContext_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHead_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHead_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAdvertisement_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAdvertisement_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAdvertisements_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAdvertisements_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEntries_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEntries_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContext_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContext_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContexts_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContexts_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectGarbage_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectGarbage_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Export_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Export_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Import_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Import_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reannounce_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reannounce_Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -672,17 +5286,71 @@ func file_depute_proto_init() {
 				return nil
 			}
 		}
+		file_depute_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnounceStatus_Sender); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnounceStatus_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_depute_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnnounceStatus_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_depute_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_depute_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_depute_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_depute_proto_msgTypes[28].OneofWrappers = []interface{}{
+		(*Metadata_Protocol_Bitswap)(nil),
+		(*Metadata_Protocol_GraphsyncFilecoinv1)(nil),
+		(*Metadata_Protocol_IpfsGatewayHttp)(nil),
+	}
+	file_depute_proto_msgTypes[34].OneofWrappers = []interface{}{}
+	file_depute_proto_msgTypes[35].OneofWrappers = []interface{}{
+		(*Advertise_Request_Header)(nil),
+		(*Advertise_Request_Multihash)(nil),
 	}
-	file_depute_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_depute_proto_msgTypes[39].OneofWrappers = []interface{}{}
+	file_depute_proto_msgTypes[41].OneofWrappers = []interface{}{}
+	file_depute_proto_msgTypes[43].OneofWrappers = []interface{}{}
+	file_depute_proto_msgTypes[45].OneofWrappers = []interface{}{}
+	file_depute_proto_msgTypes[51].OneofWrappers = []interface{}{}
+	file_depute_proto_msgTypes[61].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_depute_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   70,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_depute_proto_goTypes,
 		DependencyIndexes: file_depute_proto_depIdxs,
@@ -23,7 +23,19 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PublisherClient interface {
 	NotifyContent(ctx context.Context, opts ...grpc.CallOption) (Publisher_NotifyContentClient, error)
+	NotifyContentStream(ctx context.Context, opts ...grpc.CallOption) (Publisher_NotifyContentStreamClient, error)
+	Advertise(ctx context.Context, opts ...grpc.CallOption) (Publisher_AdvertiseClient, error)
+	ResumeContent(ctx context.Context, in *ResumeContent_Request, opts ...grpc.CallOption) (*ResumeContent_Response, error)
 	Publish(ctx context.Context, in *Publish_Request, opts ...grpc.CallOption) (*Publish_Response, error)
+	BatchPublish(ctx context.Context, in *BatchPublish_Request, opts ...grpc.CallOption) (*BatchPublish_Response, error)
+	UpdateMetadata(ctx context.Context, in *UpdateMetadata_Request, opts ...grpc.CallOption) (*UpdateMetadata_Response, error)
+	RemoveContext(ctx context.Context, in *RemoveContext_Request, opts ...grpc.CallOption) (*RemoveContext_Response, error)
+	GetHead(ctx context.Context, in *GetHead_Request, opts ...grpc.CallOption) (*GetHead_Response, error)
+	GetAdvertisement(ctx context.Context, in *GetAdvertisement_Request, opts ...grpc.CallOption) (*GetAdvertisement_Response, error)
+	ListAdvertisements(ctx context.Context, in *ListAdvertisements_Request, opts ...grpc.CallOption) (Publisher_ListAdvertisementsClient, error)
+	GetEntries(ctx context.Context, in *GetEntries_Request, opts ...grpc.CallOption) (Publisher_GetEntriesClient, error)
+	GetContext(ctx context.Context, in *GetContext_Request, opts ...grpc.CallOption) (*GetContext_Response, error)
+	ListContexts(ctx context.Context, in *ListContexts_Request, opts ...grpc.CallOption) (Publisher_ListContextsClient, error)
 }
 
 type publisherClient struct {
@@ -68,6 +80,80 @@ func (x *publisherNotifyContentClient) CloseAndRecv() (*NotifyContent_Response,
 	return m, nil
 }
 
+func (c *publisherClient) NotifyContentStream(ctx context.Context, opts ...grpc.CallOption) (Publisher_NotifyContentStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Publisher_ServiceDesc.Streams[1], "/ipni.depute.v0.Publisher/NotifyContentStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publisherNotifyContentStreamClient{stream}
+	return x, nil
+}
+
+type Publisher_NotifyContentStreamClient interface {
+	Send(*NotifyContent_Request) error
+	Recv() (*NotifyContentStream_Response, error)
+	grpc.ClientStream
+}
+
+type publisherNotifyContentStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *publisherNotifyContentStreamClient) Send(m *NotifyContent_Request) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *publisherNotifyContentStreamClient) Recv() (*NotifyContentStream_Response, error) {
+	m := new(NotifyContentStream_Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *publisherClient) Advertise(ctx context.Context, opts ...grpc.CallOption) (Publisher_AdvertiseClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Publisher_ServiceDesc.Streams[2], "/ipni.depute.v0.Publisher/Advertise", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publisherAdvertiseClient{stream}
+	return x, nil
+}
+
+type Publisher_AdvertiseClient interface {
+	Send(*Advertise_Request) error
+	CloseAndRecv() (*Advertise_Response, error)
+	grpc.ClientStream
+}
+
+type publisherAdvertiseClient struct {
+	grpc.ClientStream
+}
+
+func (x *publisherAdvertiseClient) Send(m *Advertise_Request) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *publisherAdvertiseClient) CloseAndRecv() (*Advertise_Response, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Advertise_Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *publisherClient) ResumeContent(ctx context.Context, in *ResumeContent_Request, opts ...grpc.CallOption) (*ResumeContent_Response, error) {
+	out := new(ResumeContent_Response)
+	err := c.cc.Invoke(ctx, "/ipni.depute.v0.Publisher/ResumeContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *publisherClient) Publish(ctx context.Context, in *Publish_Request, opts ...grpc.CallOption) (*Publish_Response, error) {
 	out := new(Publish_Response)
 	err := c.cc.Invoke(ctx, "/ipni.depute.v0.Publisher/Publish", in, out, opts...)
@@ -77,12 +163,174 @@ func (c *publisherClient) Publish(ctx context.Context, in *Publish_Request, opts
 	return out, nil
 }
 
+func (c *publisherClient) BatchPublish(ctx context.Context, in *BatchPublish_Request, opts ...grpc.CallOption) (*BatchPublish_Response, error) {
+	out := new(BatchPublish_Response)
+	err := c.cc.Invoke(ctx, "/ipni.depute.v0.Publisher/BatchPublish", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publisherClient) UpdateMetadata(ctx context.Context, in *UpdateMetadata_Request, opts ...grpc.CallOption) (*UpdateMetadata_Response, error) {
+	out := new(UpdateMetadata_Response)
+	err := c.cc.Invoke(ctx, "/ipni.depute.v0.Publisher/UpdateMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publisherClient) RemoveContext(ctx context.Context, in *RemoveContext_Request, opts ...grpc.CallOption) (*RemoveContext_Response, error) {
+	out := new(RemoveContext_Response)
+	err := c.cc.Invoke(ctx, "/ipni.depute.v0.Publisher/RemoveContext", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publisherClient) GetHead(ctx context.Context, in *GetHead_Request, opts ...grpc.CallOption) (*GetHead_Response, error) {
+	out := new(GetHead_Response)
+	err := c.cc.Invoke(ctx, "/ipni.depute.v0.Publisher/GetHead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publisherClient) GetAdvertisement(ctx context.Context, in *GetAdvertisement_Request, opts ...grpc.CallOption) (*GetAdvertisement_Response, error) {
+	out := new(GetAdvertisement_Response)
+	err := c.cc.Invoke(ctx, "/ipni.depute.v0.Publisher/GetAdvertisement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publisherClient) ListAdvertisements(ctx context.Context, in *ListAdvertisements_Request, opts ...grpc.CallOption) (Publisher_ListAdvertisementsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Publisher_ServiceDesc.Streams[3], "/ipni.depute.v0.Publisher/ListAdvertisements", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publisherListAdvertisementsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Publisher_ListAdvertisementsClient interface {
+	Recv() (*ListAdvertisements_Response, error)
+	grpc.ClientStream
+}
+
+type publisherListAdvertisementsClient struct {
+	grpc.ClientStream
+}
+
+func (x *publisherListAdvertisementsClient) Recv() (*ListAdvertisements_Response, error) {
+	m := new(ListAdvertisements_Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *publisherClient) GetEntries(ctx context.Context, in *GetEntries_Request, opts ...grpc.CallOption) (Publisher_GetEntriesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Publisher_ServiceDesc.Streams[4], "/ipni.depute.v0.Publisher/GetEntries", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publisherGetEntriesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Publisher_GetEntriesClient interface {
+	Recv() (*GetEntries_Response, error)
+	grpc.ClientStream
+}
+
+type publisherGetEntriesClient struct {
+	grpc.ClientStream
+}
+
+func (x *publisherGetEntriesClient) Recv() (*GetEntries_Response, error) {
+	m := new(GetEntries_Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *publisherClient) GetContext(ctx context.Context, in *GetContext_Request, opts ...grpc.CallOption) (*GetContext_Response, error) {
+	out := new(GetContext_Response)
+	err := c.cc.Invoke(ctx, "/ipni.depute.v0.Publisher/GetContext", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publisherClient) ListContexts(ctx context.Context, in *ListContexts_Request, opts ...grpc.CallOption) (Publisher_ListContextsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Publisher_ServiceDesc.Streams[5], "/ipni.depute.v0.Publisher/ListContexts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publisherListContextsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Publisher_ListContextsClient interface {
+	Recv() (*ListContexts_Response, error)
+	grpc.ClientStream
+}
+
+type publisherListContextsClient struct {
+	grpc.ClientStream
+}
+
+func (x *publisherListContextsClient) Recv() (*ListContexts_Response, error) {
+	m := new(ListContexts_Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // PublisherServer is the server API for Publisher service.
 // All implementations should embed UnimplementedPublisherServer
 // for forward compatibility
 type PublisherServer interface {
 	NotifyContent(Publisher_NotifyContentServer) error
+	NotifyContentStream(Publisher_NotifyContentStreamServer) error
+	Advertise(Publisher_AdvertiseServer) error
+	ResumeContent(context.Context, *ResumeContent_Request) (*ResumeContent_Response, error)
 	Publish(context.Context, *Publish_Request) (*Publish_Response, error)
+	BatchPublish(context.Context, *BatchPublish_Request) (*BatchPublish_Response, error)
+	UpdateMetadata(context.Context, *UpdateMetadata_Request) (*UpdateMetadata_Response, error)
+	RemoveContext(context.Context, *RemoveContext_Request) (*RemoveContext_Response, error)
+	GetHead(context.Context, *GetHead_Request) (*GetHead_Response, error)
+	GetAdvertisement(context.Context, *GetAdvertisement_Request) (*GetAdvertisement_Response, error)
+	ListAdvertisements(*ListAdvertisements_Request, Publisher_ListAdvertisementsServer) error
+	GetEntries(*GetEntries_Request, Publisher_GetEntriesServer) error
+	GetContext(context.Context, *GetContext_Request) (*GetContext_Response, error)
+	ListContexts(*ListContexts_Request, Publisher_ListContextsServer) error
 }
 
 // UnimplementedPublisherServer should be embedded to have forward compatible implementations.
@@ -92,9 +340,45 @@ type UnimplementedPublisherServer struct {
 func (UnimplementedPublisherServer) NotifyContent(Publisher_NotifyContentServer) error {
 	return status.Errorf(codes.Unimplemented, "method NotifyContent not implemented")
 }
+func (UnimplementedPublisherServer) NotifyContentStream(Publisher_NotifyContentStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method NotifyContentStream not implemented")
+}
+func (UnimplementedPublisherServer) Advertise(Publisher_AdvertiseServer) error {
+	return status.Errorf(codes.Unimplemented, "method Advertise not implemented")
+}
+func (UnimplementedPublisherServer) ResumeContent(context.Context, *ResumeContent_Request) (*ResumeContent_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeContent not implemented")
+}
 func (UnimplementedPublisherServer) Publish(context.Context, *Publish_Request) (*Publish_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
 }
+func (UnimplementedPublisherServer) BatchPublish(context.Context, *BatchPublish_Request) (*BatchPublish_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchPublish not implemented")
+}
+func (UnimplementedPublisherServer) UpdateMetadata(context.Context, *UpdateMetadata_Request) (*UpdateMetadata_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMetadata not implemented")
+}
+func (UnimplementedPublisherServer) RemoveContext(context.Context, *RemoveContext_Request) (*RemoveContext_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveContext not implemented")
+}
+func (UnimplementedPublisherServer) GetHead(context.Context, *GetHead_Request) (*GetHead_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHead not implemented")
+}
+func (UnimplementedPublisherServer) GetAdvertisement(context.Context, *GetAdvertisement_Request) (*GetAdvertisement_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdvertisement not implemented")
+}
+func (UnimplementedPublisherServer) ListAdvertisements(*ListAdvertisements_Request, Publisher_ListAdvertisementsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListAdvertisements not implemented")
+}
+func (UnimplementedPublisherServer) GetEntries(*GetEntries_Request, Publisher_GetEntriesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetEntries not implemented")
+}
+func (UnimplementedPublisherServer) GetContext(context.Context, *GetContext_Request) (*GetContext_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContext not implemented")
+}
+func (UnimplementedPublisherServer) ListContexts(*ListContexts_Request, Publisher_ListContextsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListContexts not implemented")
+}
 
 // UnsafePublisherServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PublisherServer will
@@ -133,6 +417,76 @@ func (x *publisherNotifyContentServer) Recv() (*NotifyContent_Request, error) {
 	return m, nil
 }
 
+func _Publisher_NotifyContentStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PublisherServer).NotifyContentStream(&publisherNotifyContentStreamServer{stream})
+}
+
+type Publisher_NotifyContentStreamServer interface {
+	Send(*NotifyContentStream_Response) error
+	Recv() (*NotifyContent_Request, error)
+	grpc.ServerStream
+}
+
+type publisherNotifyContentStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *publisherNotifyContentStreamServer) Send(m *NotifyContentStream_Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *publisherNotifyContentStreamServer) Recv() (*NotifyContent_Request, error) {
+	m := new(NotifyContent_Request)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Publisher_Advertise_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PublisherServer).Advertise(&publisherAdvertiseServer{stream})
+}
+
+type Publisher_AdvertiseServer interface {
+	SendAndClose(*Advertise_Response) error
+	Recv() (*Advertise_Request, error)
+	grpc.ServerStream
+}
+
+type publisherAdvertiseServer struct {
+	grpc.ServerStream
+}
+
+func (x *publisherAdvertiseServer) SendAndClose(m *Advertise_Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *publisherAdvertiseServer) Recv() (*Advertise_Request, error) {
+	m := new(Advertise_Request)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Publisher_ResumeContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeContent_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServer).ResumeContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ipni.depute.v0.Publisher/ResumeContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServer).ResumeContent(ctx, req.(*ResumeContent_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Publisher_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Publish_Request)
 	if err := dec(in); err != nil {
@@ -151,22 +505,537 @@ func _Publisher_Publish_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-// Publisher_ServiceDesc is the grpc.ServiceDesc for Publisher service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Publisher_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "ipni.depute.v0.Publisher",
-	HandlerType: (*PublisherServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Publish",
-			Handler:    _Publisher_Publish_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "NotifyContent",
-			Handler:       _Publisher_NotifyContent_Handler,
+func _Publisher_BatchPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchPublish_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServer).BatchPublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ipni.depute.v0.Publisher/BatchPublish",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServer).BatchPublish(ctx, req.(*BatchPublish_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Publisher_UpdateMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMetadata_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServer).UpdateMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ipni.depute.v0.Publisher/UpdateMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServer).UpdateMetadata(ctx, req.(*UpdateMetadata_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Publisher_RemoveContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveContext_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServer).RemoveContext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ipni.depute.v0.Publisher/RemoveContext",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServer).RemoveContext(ctx, req.(*RemoveContext_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Publisher_GetHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHead_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServer).GetHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ipni.depute.v0.Publisher/GetHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServer).GetHead(ctx, req.(*GetHead_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Publisher_GetAdvertisement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdvertisement_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServer).GetAdvertisement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ipni.depute.v0.Publisher/GetAdvertisement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServer).GetAdvertisement(ctx, req.(*GetAdvertisement_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Publisher_ListAdvertisements_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAdvertisements_Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PublisherServer).ListAdvertisements(m, &publisherListAdvertisementsServer{stream})
+}
+
+type Publisher_ListAdvertisementsServer interface {
+	Send(*ListAdvertisements_Response) error
+	grpc.ServerStream
+}
+
+type publisherListAdvertisementsServer struct {
+	grpc.ServerStream
+}
+
+func (x *publisherListAdvertisementsServer) Send(m *ListAdvertisements_Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Publisher_GetEntries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetEntries_Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PublisherServer).GetEntries(m, &publisherGetEntriesServer{stream})
+}
+
+type Publisher_GetEntriesServer interface {
+	Send(*GetEntries_Response) error
+	grpc.ServerStream
+}
+
+type publisherGetEntriesServer struct {
+	grpc.ServerStream
+}
+
+func (x *publisherGetEntriesServer) Send(m *GetEntries_Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Publisher_GetContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContext_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublisherServer).GetContext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ipni.depute.v0.Publisher/GetContext",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublisherServer).GetContext(ctx, req.(*GetContext_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Publisher_ListContexts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListContexts_Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PublisherServer).ListContexts(m, &publisherListContextsServer{stream})
+}
+
+type Publisher_ListContextsServer interface {
+	Send(*ListContexts_Response) error
+	grpc.ServerStream
+}
+
+type publisherListContextsServer struct {
+	grpc.ServerStream
+}
+
+func (x *publisherListContextsServer) Send(m *ListContexts_Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Publisher_ServiceDesc is the grpc.ServiceDesc for Publisher service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Publisher_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ipni.depute.v0.Publisher",
+	HandlerType: (*PublisherServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ResumeContent",
+			Handler:    _Publisher_ResumeContent_Handler,
+		},
+		{
+			MethodName: "Publish",
+			Handler:    _Publisher_Publish_Handler,
+		},
+		{
+			MethodName: "BatchPublish",
+			Handler:    _Publisher_BatchPublish_Handler,
+		},
+		{
+			MethodName: "UpdateMetadata",
+			Handler:    _Publisher_UpdateMetadata_Handler,
+		},
+		{
+			MethodName: "RemoveContext",
+			Handler:    _Publisher_RemoveContext_Handler,
+		},
+		{
+			MethodName: "GetHead",
+			Handler:    _Publisher_GetHead_Handler,
+		},
+		{
+			MethodName: "GetAdvertisement",
+			Handler:    _Publisher_GetAdvertisement_Handler,
+		},
+		{
+			MethodName: "GetContext",
+			Handler:    _Publisher_GetContext_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "NotifyContent",
+			Handler:       _Publisher_NotifyContent_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "NotifyContentStream",
+			Handler:       _Publisher_NotifyContentStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Advertise",
+			Handler:       _Publisher_Advertise_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ListAdvertisements",
+			Handler:       _Publisher_ListAdvertisements_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetEntries",
+			Handler:       _Publisher_GetEntries_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListContexts",
+			Handler:       _Publisher_ListContexts_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "depute.proto",
+}
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminClient interface {
+	CollectGarbage(ctx context.Context, in *CollectGarbage_Request, opts ...grpc.CallOption) (*CollectGarbage_Response, error)
+	Export(ctx context.Context, in *Export_Request, opts ...grpc.CallOption) (Admin_ExportClient, error)
+	Import(ctx context.Context, opts ...grpc.CallOption) (Admin_ImportClient, error)
+	Reannounce(ctx context.Context, in *Reannounce_Request, opts ...grpc.CallOption) (*Reannounce_Response, error)
+	AnnounceStatus(ctx context.Context, in *AnnounceStatus_Request, opts ...grpc.CallOption) (*AnnounceStatus_Response, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) CollectGarbage(ctx context.Context, in *CollectGarbage_Request, opts ...grpc.CallOption) (*CollectGarbage_Response, error) {
+	out := new(CollectGarbage_Response)
+	err := c.cc.Invoke(ctx, "/ipni.depute.v0.Admin/CollectGarbage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Export(ctx context.Context, in *Export_Request, opts ...grpc.CallOption) (Admin_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Admin_ServiceDesc.Streams[0], "/ipni.depute.v0.Admin/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Admin_ExportClient interface {
+	Recv() (*Export_Response, error)
+	grpc.ClientStream
+}
+
+type adminExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminExportClient) Recv() (*Export_Response, error) {
+	m := new(Export_Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminClient) Import(ctx context.Context, opts ...grpc.CallOption) (Admin_ImportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Admin_ServiceDesc.Streams[1], "/ipni.depute.v0.Admin/Import", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminImportClient{stream}
+	return x, nil
+}
+
+type Admin_ImportClient interface {
+	Send(*Import_Request) error
+	CloseAndRecv() (*Import_Response, error)
+	grpc.ClientStream
+}
+
+type adminImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminImportClient) Send(m *Import_Request) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *adminImportClient) CloseAndRecv() (*Import_Response, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Import_Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminClient) Reannounce(ctx context.Context, in *Reannounce_Request, opts ...grpc.CallOption) (*Reannounce_Response, error) {
+	out := new(Reannounce_Response)
+	err := c.cc.Invoke(ctx, "/ipni.depute.v0.Admin/Reannounce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) AnnounceStatus(ctx context.Context, in *AnnounceStatus_Request, opts ...grpc.CallOption) (*AnnounceStatus_Response, error) {
+	out := new(AnnounceStatus_Response)
+	err := c.cc.Invoke(ctx, "/ipni.depute.v0.Admin/AnnounceStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations should embed UnimplementedAdminServer
+// for forward compatibility
+type AdminServer interface {
+	CollectGarbage(context.Context, *CollectGarbage_Request) (*CollectGarbage_Response, error)
+	Export(*Export_Request, Admin_ExportServer) error
+	Import(Admin_ImportServer) error
+	Reannounce(context.Context, *Reannounce_Request) (*Reannounce_Response, error)
+	AnnounceStatus(context.Context, *AnnounceStatus_Request) (*AnnounceStatus_Response, error)
+}
+
+// UnimplementedAdminServer should be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (UnimplementedAdminServer) CollectGarbage(context.Context, *CollectGarbage_Request) (*CollectGarbage_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectGarbage not implemented")
+}
+func (UnimplementedAdminServer) Export(*Export_Request, Admin_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (UnimplementedAdminServer) Import(Admin_ImportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (UnimplementedAdminServer) Reannounce(context.Context, *Reannounce_Request) (*Reannounce_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reannounce not implemented")
+}
+func (UnimplementedAdminServer) AnnounceStatus(context.Context, *AnnounceStatus_Request) (*AnnounceStatus_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnounceStatus not implemented")
+}
+
+// UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServer will
+// result in compilation errors.
+type UnsafeAdminServer interface {
+	mustEmbedUnimplementedAdminServer()
+}
+
+func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
+	s.RegisterService(&Admin_ServiceDesc, srv)
+}
+
+func _Admin_CollectGarbage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectGarbage_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CollectGarbage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ipni.depute.v0.Admin/CollectGarbage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CollectGarbage(ctx, req.(*CollectGarbage_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Export_Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServer).Export(m, &adminExportServer{stream})
+}
+
+type Admin_ExportServer interface {
+	Send(*Export_Response) error
+	grpc.ServerStream
+}
+
+type adminExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminExportServer) Send(m *Export_Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Admin_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AdminServer).Import(&adminImportServer{stream})
+}
+
+type Admin_ImportServer interface {
+	SendAndClose(*Import_Response) error
+	Recv() (*Import_Request, error)
+	grpc.ServerStream
+}
+
+type adminImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminImportServer) SendAndClose(m *Import_Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *adminImportServer) Recv() (*Import_Request, error) {
+	m := new(Import_Request)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Admin_Reannounce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Reannounce_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Reannounce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ipni.depute.v0.Admin/Reannounce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Reannounce(ctx, req.(*Reannounce_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AnnounceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnounceStatus_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AnnounceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ipni.depute.v0.Admin/AnnounceStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AnnounceStatus(ctx, req.(*AnnounceStatus_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ipni.depute.v0.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CollectGarbage",
+			Handler:    _Admin_CollectGarbage_Handler,
+		},
+		{
+			MethodName: "Reannounce",
+			Handler:    _Admin_Reannounce_Handler,
+		},
+		{
+			MethodName: "AnnounceStatus",
+			Handler:    _Admin_AnnounceStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _Admin_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Import",
+			Handler:       _Admin_Import_Handler,
 			ClientStreams: true,
 		},
 	},
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"crypto/tls"
+	"flag"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// clientTLSFlags are the flags of subcommands that set how the connection to
+// the gRPC server of a running depute is secured.
+type clientTLSFlags struct {
+	enabled *bool
+	caPath  *string
+}
+
+func newClientTLSFlags(fs *flag.FlagSet) clientTLSFlags {
+	return clientTLSFlags{
+		enabled: fs.Bool("grpcTls", false, "Connect to the gRPC server over TLS, verifying its certificate with the system CA certificates unless grpcTlsCaPath is specified."),
+		caPath:  fs.String("grpcTlsCaPath", "", "Path to the CA certificate that verifies the gRPC server TLS certificate. Implies grpcTls."),
+	}
+}
+
+// dialDepute connects to the gRPC server of a running depute at the given
+// address.
+func dialDepute(addr string, f clientTLSFlags) *grpc.ClientConn {
+	creds := insecure.NewCredentials()
+	switch {
+	case *f.caPath != "":
+		var err error
+		creds, err = credentials.NewClientTLSFromFile(*f.caPath, "")
+		if err != nil {
+			logger.Fatalw("Failed to instantiate client TLS credentials", "err", err)
+		}
+	case *f.enabled:
+		creds = credentials.NewTLS(&tls.Config{})
+	}
+	cc, err := grpc.Dial(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		logger.Fatalw("Failed to dial depute", "err", err)
+	}
+	return cc
+}
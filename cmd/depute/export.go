@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ipfs/go-cid"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	depute "github.com/ipni/depute/api/v0"
+)
+
+// export writes the advertisement chain served by a running depute to a CAR
+// file.
+func export(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	grpcAddr := fs.String("grpcAddr", "localhost:40080", "The gRPC server address of the depute to export from.")
+	tlsFlags := newClientTLSFlags(fs)
+	output := fs.String("o", "", "Path to the CAR file to write. Required.")
+	excludeEntries := fs.Bool("excludeEntries", false, "Exclude the entries of advertisements.")
+	stopAt := fs.String("stopAt", "", "CID of the advertisement at which to stop, exclusive. If unspecified, the whole chain is exported.")
+	logLevel := fs.String("logLevel", "info", "Logging level. Only applied if GOLOG_LOG_LEVEL environment variable is unset.")
+	_ = fs.Parse(args)
+	setLogLevel(*logLevel)
+
+	if *output == "" {
+		logger.Fatal("Output path must be specified.")
+	}
+	req := &depute.Export_Request{
+		ExcludeEntries: *excludeEntries,
+	}
+	if *stopAt != "" {
+		c, err := cid.Decode(*stopAt)
+		if err != nil {
+			logger.Fatalw("Invalid stop at CID", "err", err)
+		}
+		var l depute.Link
+		if err := l.Marshal(cidlink.Link{Cid: c}); err != nil {
+			logger.Fatalw("Failed to marshal stop at link", "err", err)
+		}
+		req.StopAt = &l
+	}
+
+	cc := dialDepute(*grpcAddr, tlsFlags)
+	defer cc.Close()
+	stream, err := depute.NewAdminClient(cc).Export(context.Background(), req)
+	if err != nil {
+		logger.Fatalw("Failed to export", "err", err)
+	}
+
+	p := filepath.Clean(*output)
+	f, err := os.Create(p)
+	if err != nil {
+		logger.Fatalw("Failed to create output file", "path", p, "err", err)
+	}
+	var size int
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(p)
+			logger.Fatalw("Failed to export", "err", err)
+		}
+		if _, err := f.Write(resp.GetData()); err != nil {
+			_ = f.Close()
+			_ = os.Remove(p)
+			logger.Fatalw("Failed to write output file", "path", p, "err", err)
+		}
+		size += len(resp.GetData())
+	}
+	if err := f.Close(); err != nil {
+		logger.Fatalw("Failed to close output file", "path", p, "err", err)
+	}
+	logger.Infow("Exported advertisement chain", "path", p, "size", size)
+}
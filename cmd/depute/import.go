@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+
+	depute "github.com/ipni/depute/api/v0"
+)
+
+// importChunkSize is the maximum number of bytes of CAR data sent per import
+// request.
+const importChunkSize = 1 << 20
+
+// importCar imports an advertisement chain from a CAR file into a running
+// depute.
+func importCar(args []string) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	grpcAddr := fs.String("grpcAddr", "localhost:40080", "The gRPC server address of the depute to import into.")
+	tlsFlags := newClientTLSFlags(fs)
+	input := fs.String("i", "", "Path to the CAR file to import, rooted at the advertisement chain head. Required.")
+	force := fs.Bool("force", false, "Replace the advertisement chain head even if the imported chain does not extend it, or import a partial chain.")
+	logLevel := fs.String("logLevel", "info", "Logging level. Only applied if GOLOG_LOG_LEVEL environment variable is unset.")
+	_ = fs.Parse(args)
+	setLogLevel(*logLevel)
+
+	if *input == "" {
+		logger.Fatal("Input path must be specified.")
+	}
+	p := filepath.Clean(*input)
+	f, err := os.Open(p)
+	if err != nil {
+		logger.Fatalw("Failed to open input file", "path", p, "err", err)
+	}
+	defer f.Close()
+
+	cc := dialDepute(*grpcAddr, tlsFlags)
+	defer cc.Close()
+	stream, err := depute.NewAdminClient(cc).Import(context.Background())
+	if err != nil {
+		logger.Fatalw("Failed to import", "err", err)
+	}
+	buf := make([]byte, importChunkSize)
+	req := &depute.Import_Request{Force: *force}
+	for {
+		n, err := io.ReadFull(f, buf)
+		if n != 0 {
+			req.Data = buf[:n]
+			if err := stream.Send(req); err != nil {
+				logger.Fatalw("Failed to import", "err", err)
+			}
+			req = &depute.Import_Request{}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			logger.Fatalw("Failed to read input file", "path", p, "err", err)
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		logger.Fatalw("Failed to import", "err", err)
+	}
+	head, err := resp.GetLink().Unmarshal()
+	if err != nil {
+		logger.Fatalw("Invalid imported head link", "err", err)
+	}
+	logger.Infow("Imported advertisement chain", "head", head.String(), "ads", resp.GetAdvertisements(), "blocks", resp.GetBlocks())
+}
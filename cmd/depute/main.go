@@ -12,6 +12,7 @@ import (
 	"github.com/ipni/depute"
 	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/multiformats/go-multicodec"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 )
@@ -34,6 +35,17 @@ func (a *arrayFlags) Set(value string) error {
 }
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "export":
+			export(os.Args[2:])
+			return
+		case "import":
+			importCar(os.Args[2:])
+			return
+		}
+	}
+
 	httpListenAddr := flag.String("httpListenAddr", "", "Address to listen on for publishing advertisements over HTTP.")
 	var directAnnounceURLs arrayFlags
 	flag.Var(&directAnnounceURLs, "directAnnounceURL", "Indexer URL to send direct http announcement to. Multiple OK")
@@ -48,12 +60,25 @@ func main() {
 	grpcTlsCertPath := flag.String("grpcTlsCertPath", "", "Path to gRPC server TLS Certificate.")
 	grpcTlsKeyPath := flag.String("grpcTlsKeyPath", "", "Path to gRPC server TLS Key.")
 	logLevel := flag.String("logLevel", "info", "Logging level. Only applied if GOLOG_LOG_LEVEL environment variable is unset.")
+	dataDir := flag.String("dataDir", "", "Path to the directory in which advertisements and entries are persisted. If unspecified, an in-memory datastore is used and all state is lost on exit.")
 	topic := flag.String("topic", depute.DefaultTopic, "Sets the topic that pubsub messages are send on.")
+	entriesChunkSize := flag.Int("entriesChunkSize", depute.DefaultEntriesChunkSize, "Maximum number of multihashes per advertisement entries chunk.")
+	hamtEntries := flag.Bool("hamtEntries", false, "Store advertisement entries as a HAMT instead of a chain of entry chunks. Suited to very large content sets.")
+	hamtBitWidth := flag.Int("hamtBitWidth", 5, "Bit-width of the HAMT used to store advertisement entries. Only applied if hamtEntries is set.")
+	hamtBucketSize := flag.Int("hamtBucketSize", 3, "Bucket size of the HAMT used to store advertisement entries. Only applied if hamtEntries is set.")
+	dedupMemoryLimit := flag.Int("dedupMemoryLimit", depute.DefaultDedupMemoryLimit, "Maximum number of multihashes held in memory per de-duplicating content notification, beyond which they are spilled to the datastore.")
+	progressInterval := flag.Duration("progressInterval", depute.DefaultProgressInterval, "Interval at which the progress of bidirectional content notifications is reported.")
+	sessionExpiry := flag.Duration("sessionExpiry", depute.DefaultSessionExpiry, "Time after its last update at which a resumable content upload session is removed.")
+	gcInterval := flag.Duration("gcInterval", 0, "Interval at which blocks no longer reachable from the advertisement chain are garbage collected. If unspecified, garbage is only collected on demand.")
+	gcGracePeriod := flag.Duration("gcGracePeriod", depute.DefaultGCGracePeriod, "Time for which a block must have been unreachable before it is garbage collected.")
+	reannounceInterval := flag.Duration("reannounceInterval", 0, "Interval at which the advertisement chain head is announced again. If unspecified, the head is only announced when published.")
+	announceWindow := flag.Duration("announceWindow", 0, "Time for which announcements are held back once an advertisement is published, after which only the newest advertisement is announced. If unspecified, advertisements are announced as they are published.")
+	announceRetryMinBackoff := flag.Duration("announceRetryMinBackoff", depute.DefaultAnnounceRetryMinBackoff, "Delay before the first retry of a failed HTTP announcement, which doubles after each failed retry.")
+	announceRetryMaxBackoff := flag.Duration("announceRetryMaxBackoff", depute.DefaultAnnounceRetryMaxBackoff, "Maximum delay between retries of a failed HTTP announcement.")
+	multihashCodes := flag.String("multihashCodes", "", "Comma separated multihash codes accepted in content notifications, e.g. sha2-256,blake3. If unspecified, all codes are accepted.")
 	flag.Parse()
 
-	if _, set := os.LookupEnv("GOLOG_LOG_LEVEL"); !set {
-		_ = log.SetLogLevel("*", *logLevel)
-	}
+	setLogLevel(*logLevel)
 
 	hOpts := []libp2p.Option{
 		libp2p.UserAgent(libp2pUserAgent),
@@ -84,6 +109,9 @@ func main() {
 		depute.WithHost(h),
 		depute.WithGrpcListenAddr(*grpcListenAddr),
 	}
+	if *dataDir != "" {
+		deputeOpts = append(deputeOpts, depute.WithDataDir(filepath.Clean(*dataDir)))
+	}
 	if *retrievalAddrs != "" {
 		rAddrs := strings.Split(*libp2pListenAddrs, ",")
 		deputeOpts = append(deputeOpts, depute.WithRetrievalAddrs(rAddrs...))
@@ -99,6 +127,29 @@ func main() {
 	if len(pubAddrs) != 0 {
 		deputeOpts = append(deputeOpts, depute.WithPublishAddrs(pubAddrs))
 	}
+	if *hamtEntries {
+		deputeOpts = append(deputeOpts, depute.WithHamtEntries(multicodec.Murmur3X64_64, *hamtBitWidth, *hamtBucketSize))
+	} else {
+		deputeOpts = append(deputeOpts, depute.WithEntriesChunkSize(*entriesChunkSize))
+	}
+	deputeOpts = append(deputeOpts, depute.WithDedupMemoryLimit(*dedupMemoryLimit))
+	deputeOpts = append(deputeOpts, depute.WithProgressInterval(*progressInterval))
+	deputeOpts = append(deputeOpts, depute.WithSessionExpiry(*sessionExpiry))
+	deputeOpts = append(deputeOpts, depute.WithGCInterval(*gcInterval), depute.WithGCGracePeriod(*gcGracePeriod))
+	deputeOpts = append(deputeOpts, depute.WithReannounceInterval(*reannounceInterval))
+	deputeOpts = append(deputeOpts, depute.WithAnnounceWindow(*announceWindow))
+	deputeOpts = append(deputeOpts, depute.WithAnnounceRetryBackoff(*announceRetryMinBackoff, *announceRetryMaxBackoff))
+	if *multihashCodes != "" {
+		var mhCodes []multicodec.Code
+		for _, name := range strings.Split(*multihashCodes, ",") {
+			var code multicodec.Code
+			if err := code.Set(strings.TrimSpace(name)); err != nil {
+				logger.Fatalw("Invalid multihash code", "code", name, "err", err)
+			}
+			mhCodes = append(mhCodes, code)
+		}
+		deputeOpts = append(deputeOpts, depute.WithMultihashCodes(mhCodes...))
+	}
 
 	var gsOpts []grpc.ServerOption
 	// TODO: expose more flags for gRPC server options.
@@ -134,3 +185,11 @@ func main() {
 		logger.Info("Shut down server successfully.")
 	}
 }
+
+// setLogLevel sets the logging level unless set by the GOLOG_LOG_LEVEL
+// environment variable.
+func setLogLevel(level string) {
+	if _, set := os.LookupEnv("GOLOG_LOG_LEVEL"); !set {
+		_ = log.SetLogLevel("*", level)
+	}
+}
@@ -0,0 +1,55 @@
+package depute
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ipfs/go-datastore"
+	leveldb "github.com/ipfs/go-ds-leveldb"
+	fslock "github.com/ipfs/go-fs-lock"
+)
+
+const (
+	dataDirLockFile  = "repo.lock"
+	dataDirDatastore = "datastore"
+)
+
+// openDataDir opens the persistent datastore within the given data directory,
+// creating the directory if it does not exist. The directory is locked so that
+// it cannot be opened by more than one process at a time; the returned closer
+// releases the lock.
+//
+// The data directory is laid out as:
+//   - repo.lock: the lock file held while the directory is in use.
+//   - datastore: the LevelDB datastore that holds the advertisement chain,
+//     entries and depute state.
+func openDataDir(dir string) (datastore.Batching, io.Closer, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, nil, fmt.Errorf("cannot create data directory: %w", err)
+	}
+	lock, err := fslock.Lock(dir, dataDirLockFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot lock data directory: %w", err)
+	}
+	ds, err := leveldb.NewDatastore(filepath.Join(dir, dataDirDatastore), nil)
+	if err != nil {
+		_ = lock.Close()
+		return nil, nil, fmt.Errorf("cannot open datastore: %w", err)
+	}
+	return ds, lock, nil
+}
+
+// closeDataDir closes the datastore opened in the data directory, if any, and
+// releases the directory lock.
+func (o *options) closeDataDir() error {
+	if o.dsCloser == nil {
+		return nil
+	}
+	err := o.ds.Close()
+	if lErr := o.dsCloser.Close(); err == nil {
+		err = lErr
+	}
+	return err
+}
@@ -0,0 +1,137 @@
+package depute
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/multiformats/go-multihash"
+)
+
+// dsKeyPrefixDedup is the datastore key prefix under which the multihashes
+// seen by de-duplicating NotifyContent streams are spilled, keyed by stream
+// sequence number and hex encoded multihash.
+var dsKeyPrefixDedup = datastore.NewKey("depute/dedup")
+
+// mhSet is a set of multihashes that holds at most maxMem multihashes in
+// memory, spilling the rest to the datastore. If maxMem is zero, the set only
+// spills when told to.
+type mhSet struct {
+	ds     datastore.Batching
+	prefix datastore.Key
+	maxMem int
+	// persistent sets whether the multihashes spilled outlive the set, as
+	// they do for resumable upload sessions.
+	persistent bool
+
+	mem     map[string]struct{}
+	spilled bool
+}
+
+func (d *Depute) newMhSet() *mhSet {
+	seq := d.dedupSeq.Add(1)
+	return &mhSet{
+		ds:     d.ds,
+		prefix: dsKeyPrefixDedup.ChildString(strconv.FormatUint(seq, 10)),
+		maxMem: d.dedupMemLimit,
+		mem:    make(map[string]struct{}),
+	}
+}
+
+// add adds the given multihash to the set, and returns whether it was absent.
+func (s *mhSet) add(ctx context.Context, mh multihash.Multihash) (bool, error) {
+	k := string(mh)
+	if _, ok := s.mem[k]; ok {
+		return false, nil
+	}
+	if s.spilled {
+		found, err := s.ds.Has(ctx, s.key(mh))
+		if err != nil || found {
+			return false, err
+		}
+	}
+	s.mem[k] = struct{}{}
+	if s.maxMem > 0 && len(s.mem) >= s.maxMem {
+		if err := s.spill(ctx); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// spill moves the multihashes held in memory to the datastore.
+func (s *mhSet) spill(ctx context.Context) error {
+	b, err := s.ds.Batch(ctx)
+	if err != nil {
+		return err
+	}
+	if err := s.writeMem(ctx, b); err != nil {
+		return err
+	}
+	if err := b.Commit(ctx); err != nil {
+		return err
+	}
+	s.memSpilled()
+	return nil
+}
+
+// writeMem writes the multihashes held in memory to w. Once written, the
+// caller must call memSpilled.
+func (s *mhSet) writeMem(ctx context.Context, w datastore.Write) error {
+	for k := range s.mem {
+		if err := w.Put(ctx, s.key(multihash.Multihash(k)), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// memSpilled releases the multihashes held in memory once written to the
+// datastore.
+func (s *mhSet) memSpilled() {
+	if !s.spilled {
+		logger.Debugw("Spilled multihash set to datastore", "prefix", s.prefix)
+	}
+	s.spilled = true
+	s.mem = make(map[string]struct{})
+}
+
+func (s *mhSet) key(mh multihash.Multihash) datastore.Key {
+	return s.prefix.ChildString(hex.EncodeToString(mh))
+}
+
+// close releases the multihashes spilled to the datastore, if any.
+func (s *mhSet) close(ctx context.Context) error {
+	s.mem = nil
+	if !s.spilled || s.persistent {
+		return nil
+	}
+	return deletePrefix(ctx, s.ds, s.prefix)
+}
+
+// deletePrefix deletes all the keys under the given prefix.
+func deletePrefix(ctx context.Context, ds datastore.Batching, prefix datastore.Key) error {
+	results, err := ds.Query(ctx, query.Query{
+		Prefix:   prefix.String(),
+		KeysOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+	defer results.Close()
+	b, err := ds.Batch(ctx)
+	if err != nil {
+		return err
+	}
+	for r := range results.Next() {
+		if r.Error != nil {
+			return r.Error
+		}
+		if err := b.Delete(ctx, datastore.RawKey(r.Key)); err != nil {
+			return err
+		}
+	}
+	return b.Commit(ctx)
+}
@@ -1,24 +1,32 @@
 package depute
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/gogo/googleapis/google/rpc"
 	"github.com/gogo/status"
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
 	"github.com/ipfs/go-log/v2"
 	"github.com/ipld/go-ipld-prime"
 	_ "github.com/ipld/go-ipld-prime/codec/dagcbor"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
 	depute "github.com/ipni/depute/api/v0"
-	"github.com/ipni/go-libipni/announce"
 	"github.com/ipni/go-libipni/announce/httpsender"
 	"github.com/ipni/go-libipni/announce/p2psender"
 	"github.com/ipni/go-libipni/ingest/schema"
-	"github.com/ipni/index-provider/engine/chunker"
 	"github.com/multiformats/go-multicodec"
+	"github.com/multiformats/go-multihash"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 )
@@ -27,6 +35,7 @@ var (
 	logger = log.Logger("depute")
 
 	_ depute.PublisherServer = (*Depute)(nil)
+	_ depute.AdminServer     = (*Depute)(nil)
 
 	linkPrototype = cidlink.LinkPrototype{
 		Prefix: cid.Prefix{
@@ -38,22 +47,69 @@ var (
 	}
 
 	dsKeyLatestAdLink = datastore.NewKey("depute/latestAdLink")
+
+	errHeadChanged = errors.New("latest ad link has changed")
 )
 
+// maxRemoveContextIDs is the maximum number of context IDs removed by a
+// single RemoveContext call, all of which are published in a single batch.
+const maxRemoveContextIDs = 10000
+
 type Depute struct {
 	*options
-	chunker *chunker.ChainChunker
-	senders []announce.Sender
-	server  *grpc.Server
+	announcers []*announcer
+	server     *grpc.Server
+
+	// headLock serializes advancement of the advertisement chain head, and
+	// keeps the persisted head and the publisher root in step. headSeq counts
+	// the head updates, so that announcements of older heads can be told
+	// apart.
+	headLock sync.Mutex
+	headSeq  uint64
+	// dedupSeq numbers de-duplicating NotifyContent streams.
+	dedupSeq atomic.Uint64
+
+	// sessionsLock guards activeSessions, the IDs of the resumable upload
+	// sessions in use by a stream.
+	sessionsLock   sync.Mutex
+	activeSessions map[string]struct{}
+
+	// gcLock prevents concurrent garbage collections.
+	gcLock sync.Mutex
+	// storesLock is held shared by streams while they store entries, and
+	// exclusively by garbage collection while it sweeps, so that the entries
+	// stored meanwhile are marked. trackersLock guards trackers, which record
+	// the entries stored by running streams as garbage collection roots.
+	storesLock   sync.RWMutex
+	trackersLock sync.Mutex
+	trackers     map[*entriesTracker]struct{}
+
+	// announceLock guards pendingAnnounce, which is set if the chain head is
+	// to be announced once the announce window elapses. announceQueued
+	// signals the announce loop that an announcement is pending.
+	announceLock    sync.Mutex
+	pendingAnnounce bool
+	announceQueued  chan struct{}
+
+	// closing is closed on Shutdown to stop background tasks, which are
+	// tracked by wg.
+	closing chan struct{}
+	wg      sync.WaitGroup
 }
 
-func New(o ...Option) (*Depute, error) {
+func New(o ...Option) (_ *Depute, err error) {
 	opts, err := newOptions(o...)
 	if err != nil {
 		return nil, err
 	}
+	defer func() {
+		// Release the data directory so that it can be opened again.
+		if err != nil {
+			_ = opts.closeDataDir()
+		}
+	}()
 
-	var senders []announce.Sender
+	var announcers []*announcer
 	if opts.h != nil && len(opts.publishAddrs) != 0 {
 		if !opts.noPubsubAnnounce {
 			// Create an announce sender to send over gossip pubsub.
@@ -61,99 +117,820 @@ func New(o ...Option) (*Depute, error) {
 			if err != nil {
 				return nil, fmt.Errorf("cannot create p2p pubsub announce sender: %w", err)
 			}
-			senders = append(senders, p2pSender)
+			announcers = append(announcers, &announcer{name: "pubsub", sender: p2pSender})
 			logger.Info("Pubsub announcements enabled")
 		}
 		if len(opts.directAnnounceURLs) != 0 {
-			httpSender, err := httpsender.New(opts.directAnnounceURLs, opts.h.ID())
-			if err != nil {
-				return nil, fmt.Errorf("cannot create http announce sender: %w", err)
+			// Create a sender per indexer so that deliveries are tracked and
+			// retried independently.
+			for _, u := range opts.directAnnounceURLs {
+				httpSender, err := httpsender.New([]*url.URL{u}, opts.h.ID())
+				if err != nil {
+					return nil, fmt.Errorf("cannot create http announce sender: %w", err)
+				}
+				announcers = append(announcers, &announcer{name: u.String(), sender: httpSender, retry: true})
 			}
-			senders = append(senders, httpSender)
 			logger.Info("Http announcements enabled")
 		}
 	}
 
-	return &Depute{
-		options: opts,
-		senders: senders,
-		server:  grpc.NewServer(opts.grpcServerOpts...),
-	}, nil
+	// Chunkers are created per stream; check that one can be created.
+	if _, err := opts.chunker(opts.ls); err != nil {
+		return nil, fmt.Errorf("cannot create entries chunker: %w", err)
+	}
+
+	// Stop waits for running handlers, so that none announces or uses the
+	// datastore once Shutdown closes it.
+	serverOpts := make([]grpc.ServerOption, 0, len(opts.grpcServerOpts)+1)
+	serverOpts = append(serverOpts, opts.grpcServerOpts...)
+	serverOpts = append(serverOpts, grpc.WaitForHandlers(true))
+
+	d := &Depute{
+		options:    opts,
+		announcers: announcers,
+		server:     grpc.NewServer(serverOpts...),
+
+		activeSessions: make(map[string]struct{}),
+		trackers:       make(map[*entriesTracker]struct{}),
+		announceQueued: make(chan struct{}, 1),
+		closing:        make(chan struct{}),
+	}
+
+	// Release multihashes spilled by streams that did not finish cleanly.
+	if err := deletePrefix(context.Background(), d.ds, dsKeyPrefixDedup); err != nil {
+		return nil, fmt.Errorf("cannot clear multihash sets: %w", err)
+	}
+	if err := d.expireContentSessions(context.Background()); err != nil {
+		return nil, fmt.Errorf("cannot expire content sessions: %w", err)
+	}
+
+	// Restore the publisher root so that the existing advertisement chain
+	// continues to be served after a restart.
+	head, err := d.getLatestAdvertisementLink(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("cannot get latest advertisement link: %w", err)
+	}
+	if head != nil {
+		d.publisher.SetRoot(head.(cidlink.Link).Cid)
+		logger.Infow("Restored publisher root", "link", head.String())
+	}
+	return d, nil
 }
 
 func (d *Depute) NotifyContent(source depute.Publisher_NotifyContentServer) error {
-	chunk, err := d.chunker.Chunk(source.Context(), &notifyContentIter{source: source})
+	mhs, err := d.startNotifyContent(source)
 	if err != nil {
-		logger.Errorw("Failed to create entries chain chunks", "err", err)
-		return status.Errorf(codes.Internal, "failed to create entries chain chunks: %v", err)
+		return err
+	}
+	defer mhs.close()
+	ls, untrack := d.trackEntries(*d.ls)
+	defer untrack()
+	chunk, err := d.chunkContent(source.Context(), &ls, mhs)
+	if err != nil {
+		return err
 	}
 	var l depute.Link
 	if err := l.Marshal(chunk); err != nil {
 		return status.Errorf(codes.Internal, "failed to marshal link: %v", err)
 	}
 	return source.SendAndClose(&depute.NotifyContent_Response{
-		Link: &l,
+		Link:       &l,
+		Skipped:    mhs.skipped.Load(),
+		Duplicates: mhs.duplicates.Load(),
 	})
 }
 
+func (d *Depute) NotifyContentStream(stream depute.Publisher_NotifyContentStreamServer) error {
+	mhs, err := d.startNotifyContent(stream)
+	if err != nil {
+		return err
+	}
+	defer mhs.close()
+
+	// Count the chunks stored by chunking with a link system private to the
+	// stream.
+	var chunks atomic.Uint64
+	ls, untrack := d.trackEntries(countingLinkSystem(*d.ls, &chunks))
+	defer untrack()
+	progress := func() *depute.NotifyContentStream_Progress {
+		return &depute.NotifyContentStream_Progress{
+			Received:   mhs.received.Load(),
+			Chunks:     chunks.Load(),
+			Skipped:    mhs.skipped.Load(),
+			Duplicates: mhs.duplicates.Load(),
+		}
+	}
+
+	// Report progress periodically until chunking is done. The reporter is
+	// stopped before the last response is sent, since sends on a stream must
+	// not be concurrent.
+	stop := make(chan struct{})
+	reported := make(chan error, 1)
+	go func() {
+		ticker := time.NewTicker(d.progressInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				reported <- nil
+				return
+			case <-ticker.C:
+				if err := stream.Send(&depute.NotifyContentStream_Response{Progress: progress()}); err != nil {
+					reported <- err
+					return
+				}
+			}
+		}
+	}()
+	chunk, err := d.chunkContent(stream.Context(), &ls, mhs)
+	close(stop)
+	if sendErr := <-reported; err == nil && sendErr != nil {
+		return sendErr
+	}
+	if err != nil {
+		return err
+	}
+	var l depute.Link
+	if err := l.Marshal(chunk); err != nil {
+		return status.Errorf(codes.Internal, "failed to marshal link: %v", err)
+	}
+	return stream.Send(&depute.NotifyContentStream_Response{
+		Progress: progress(),
+		Link:     &l,
+	})
+}
+
+func (d *Depute) Advertise(stream depute.Publisher_AdvertiseServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Error(codes.InvalidArgument, "no header")
+		}
+		return err
+	}
+	header := req.GetHeader()
+	if header == nil {
+		return status.Error(codes.InvalidArgument, "first request must carry the header")
+	}
+	var violations []*rpc.BadRequest_FieldViolation
+	if header.GetAdvertisement().GetEntries() != nil {
+		violations = append(violations, &rpc.BadRequest_FieldViolation{
+			Field:       "header.advertisement.entries",
+			Description: "entries must be unset; they are the multihashes streamed",
+		})
+	}
+	if header.GetAdvertisement().GetRemoved() {
+		violations = append(violations, &rpc.BadRequest_FieldViolation{
+			Field:       "header.advertisement.removed",
+			Description: "removal advertisements have no entries; use Publish instead",
+		})
+	}
+	if len(violations) != 0 {
+		return invalidArgument(violations)
+	}
+	pr, err := newPublishRequest("header.advertisement", header.GetAdvertisement(), header.ExpectedPrevious)
+	if err != nil {
+		return err
+	}
+	// Fail early rather than store entries that cannot be published.
+	if pr.expectPrevious {
+		previous, err := d.getLatestAdvertisementLink(stream.Context())
+		if err != nil {
+			logger.Errorw("Failed to get latest ad link", "err", err)
+			return status.Errorf(codes.Internal, "failed to get latest ad link: %v", err)
+		}
+		if err := pr.checkPrevious(previous); err != nil {
+			return err
+		}
+	}
+
+	mhs := &notifyContentIter{
+		source:       advertiseSource{stream},
+		allowedCodes: d.mhCodes,
+		options:      header.GetOptions(),
+	}
+	if mhs.options == nil {
+		mhs.options = &depute.NotifyContent_Options{}
+	}
+	defer mhs.close()
+	// The entries are tracked until published.
+	ls, untrack := d.trackEntries(*d.ls)
+	defer untrack()
+	entries, err := d.chunkContent(stream.Context(), &ls, mhs)
+	if err != nil {
+		return err
+	}
+	// Unless multihashes were streamed, the advertisement only updates the
+	// metadata of the context ID, which must be live.
+	if entries != nil {
+		pr.entries = entries
+		pr.requireLive = false
+	}
+	link, err := d.publish(stream.Context(), pr)
+	if err != nil {
+		return err
+	}
+
+	var l depute.Link
+	if err := l.Marshal(link); err != nil {
+		return status.Errorf(codes.Internal, "failed to marshal link: %v", err)
+	}
+	resp := &depute.Advertise_Response{
+		Link:       &l,
+		Skipped:    mhs.skipped.Load(),
+		Duplicates: mhs.duplicates.Load(),
+	}
+	if entries != nil {
+		var el depute.Link
+		if err := el.Marshal(entries); err != nil {
+			return status.Errorf(codes.Internal, "failed to marshal link: %v", err)
+		}
+		resp.Entries = &el
+	}
+	return stream.SendAndClose(resp)
+}
+
+// startNotifyContent starts iterating over the multihashes received on a
+// NotifyContent stream.
+func (d *Depute) startNotifyContent(source notifyContentSource) (*notifyContentIter, error) {
+	mhs := &notifyContentIter{source: source, allowedCodes: d.mhCodes}
+	if err := mhs.start(); err != nil {
+		return nil, err
+	}
+	return mhs, nil
+}
+
+// chunkContent stores the multihashes received on a NotifyContent stream as
+// advertisement entries using the given link system, and returns the link to
+// them. The entries of resumable upload sessions are chunked by depute itself,
+// and other entries by a chunker created for the stream.
+func (d *Depute) chunkContent(ctx context.Context, ls *ipld.LinkSystem, mhs *notifyContentIter) (ipld.Link, error) {
+	var chunk ipld.Link
+	var err error
+	if mhs.options.GetSessionId() != "" {
+		chunk, err = d.chunkSession(ctx, ls, mhs)
+	} else {
+		c, cErr := d.options.chunker(ls)
+		if cErr != nil {
+			logger.Errorw("Failed to create entries chunker", "err", cErr)
+			return nil, status.Errorf(codes.Internal, "failed to create entries chunker: %v", cErr)
+		}
+		if mhs.options.GetDeduplicate() {
+			mhs.seen = d.newMhSet()
+		}
+		chunk, err = c.Chunk(ctx, mhs)
+	}
+	if err != nil {
+		if status.Code(err) != codes.Unknown {
+			return nil, err
+		}
+		logger.Errorw("Failed to create entries chain chunks", "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to create entries chain chunks: %v", err)
+	}
+	return chunk, nil
+}
+
 func (d *Depute) Publish(ctx context.Context, req *depute.Publish_Request) (*depute.Publish_Response, error) {
-	ad := req.Advertisement
+	pr, err := newPublishRequest("advertisement", req.GetAdvertisement(), req.ExpectedPrevious)
+	if err != nil {
+		return nil, err
+	}
+	pr.skipAnnounce = req.GetSkipAnnounce()
+	link, err := d.publish(ctx, pr)
+	if err != nil {
+		return nil, err
+	}
+	var l depute.Link
+	if err := l.Marshal(link); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal link: %v", err)
+	}
+	return &depute.Publish_Response{
+		Link: &l,
+	}, nil
+}
+
+// publishRequest is a validated request to publish an advertisement.
+type publishRequest struct {
+	// field is the request field that holds the advertisement, or empty if
+	// the advertisement fields are those of the request.
+	field     string
+	contextID []byte
+	metadata  []byte
+	entries   ipld.Link
+	removed   bool
+	// expectPrevious sets whether the chain head must be expectedPrevious
+	// for the advertisement to be published.
+	expectPrevious   bool
+	expectedPrevious ipld.Link
+	// skipAnnounce sets whether to publish the advertisement without
+	// announcing it.
+	skipAnnounce bool
+	// requireLive sets whether the context ID must have been advertised and
+	// not removed for the advertisement to be published, which is the case of
+	// advertisements that only update metadata.
+	requireLive bool
+}
+
+// newPublishRequest validates the given advertisement, reporting violations
+// against the given field.
+func newPublishRequest(field string, ad *depute.Advertisement, expectedPrevious *depute.Link) (*publishRequest, error) {
 	if ad == nil {
-		return nil, status.Error(codes.InvalidArgument, "no advertisement")
+		return nil, status.Errorf(codes.InvalidArgument, "no %s", field)
+	}
+	if violations := validateAdvertisement(field, ad); len(violations) != 0 {
+		return nil, invalidArgument(violations)
 	}
 
-	var entries ipld.Link
-	if ad.GetEntries() == nil {
-		entries = schema.NoEntries
-	} else {
-		var err error
-		entries, err = ad.GetEntries().Unmarshal()
+	md, err := advertisementMetadata(ad)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid metadata: %v", err)
+	}
+	pr := &publishRequest{
+		field:     field,
+		contextID: ad.GetContextId(),
+		metadata:  md,
+		entries:   schema.NoEntries,
+		removed:   ad.GetRemoved(),
+	}
+	if ad.GetEntries() != nil {
+		pr.entries, err = ad.GetEntries().Unmarshal()
 		if err != nil {
-			logger.Errorw("Failed to convert entries to link", "err", err)
 			return nil, status.Errorf(codes.InvalidArgument, "invalid entries link: %v", err)
 		}
 	}
+	// Advertisements with no entries that are not removals only update the
+	// metadata of the context ID.
+	pr.requireLive = pr.entries == schema.NoEntries && !pr.removed
+	if expectedPrevious != nil {
+		pr.expectPrevious = true
+		pr.expectedPrevious, err = expectedPrevious.Unmarshal()
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expected previous link: %v", err)
+		}
+	}
+	return pr, nil
+}
+
+// checkPrevious checks that the given chain head is the one expected by the
+// request, if any.
+func (pr *publishRequest) checkPrevious(previous ipld.Link) error {
+	if pr.expectPrevious && linkCid(pr.expectedPrevious) != linkCid(previous) {
+		return status.Errorf(codes.FailedPrecondition, "latest ad link is %s, expected %s", linkString(previous), linkString(pr.expectedPrevious))
+	}
+	return nil
+}
+
+// publish signs and stores the requested advertisement as the new head of the
+// advertisement chain, and announces it.
+func (d *Depute) publish(ctx context.Context, pr *publishRequest) (ipld.Link, error) {
+	links, err := d.publishBatch(ctx, []*publishRequest{pr})
+	if err != nil {
+		return nil, err
+	}
+	return links[0], nil
+}
+
+// publishBatch signs and stores the requested advertisements chained in order,
+// and makes the last one the head of the advertisement chain in a single
+// update. Either all or none of the advertisements are published. The chain
+// head expected by the first request, if any, is checked, and the new head is
+// announced unless the last request skips announcement.
+func (d *Depute) publishBatch(ctx context.Context, prs []*publishRequest) ([]ipld.Link, error) {
+	links, seq, err := d.advanceHead(ctx, prs)
+	if err != nil {
+		return nil, err
+	}
+	head := links[len(links)-1]
+	if !prs[len(prs)-1].skipAnnounce {
+		// The head is committed, so announce it even if the client goes away.
+		// Announcing does not hold headLock, since it may take as long as the
+		// slowest indexer; announcements overtaken by a newer head are
+		// dropped instead.
+		actx, cancel := d.closingContext()
+		d.announceHead(actx, linkCid(head), seq)
+		cancel()
+	}
+	if len(links) == 1 {
+		logger.Infow("Published advertisement", "link", head.String())
+	} else {
+		logger.Infow("Published advertisements", "count", len(links), "link", head.String())
+	}
+	return links, nil
+}
+
+// advanceHead implements publishBatch up to announcing the new head, while
+// holding headLock. It returns the links to the advertisements, and the
+// sequence number of the new head.
+func (d *Depute) advanceHead(ctx context.Context, prs []*publishRequest) ([]ipld.Link, uint64, error) {
+	d.headLock.Lock()
+	defer d.headLock.Unlock()
 	previous, err := d.getLatestAdvertisementLink(ctx)
 	if err != nil {
 		logger.Errorw("Failed to get latest ad link", "err", err)
-		return nil, status.Errorf(codes.Internal, "failed to get latest ad link: %v", err)
+		return nil, 0, status.Errorf(codes.Internal, "failed to get latest ad link: %v", err)
+	}
+	if err := prs[0].checkPrevious(previous); err != nil {
+		return nil, 0, err
+	}
+
+	// states holds the state of each context ID after the advertisements
+	// chained so far, in the order the context IDs were first published.
+	states := make(map[string]*depute.ContextState)
+	var contextIDs []string
+	links := make([]ipld.Link, 0, len(prs))
+	head := previous
+	for _, pr := range prs {
+		prevState, found := states[string(pr.contextID)]
+		if !found {
+			prevState, err = d.getContextState(ctx, pr.contextID)
+			if err != nil {
+				logger.Errorw("Failed to get context state", "err", err)
+				return nil, 0, status.Errorf(codes.Internal, "failed to get context state: %v", err)
+			}
+			contextIDs = append(contextIDs, string(pr.contextID))
+		}
+		if pr.requireLive && (prevState == nil || prevState.Removed) {
+			return nil, 0, status.Errorf(codes.NotFound, "context ID %x %s", pr.contextID, contextStatus(prevState))
+		}
+		if pr.removed && prevState == nil {
+			return nil, 0, invalidArgument([]*rpc.BadRequest_FieldViolation{{
+				Field:       fieldPath(pr.field, "context_id"),
+				Description: "cannot remove a context ID that has not been advertised",
+			}})
+		}
+		if !pr.removed && pr.entries != schema.NoEntries {
+			// Garbage collection cannot sweep the entries while headLock is
+			// held, so they remain stored once published.
+			found, err := d.ds.Has(ctx, linkKey(pr.entries))
+			if err != nil {
+				logger.Errorw("Failed to check entries", "err", err)
+				return nil, 0, status.Errorf(codes.Internal, "failed to check entries: %v", err)
+			}
+			if !found {
+				return nil, 0, status.Errorf(codes.FailedPrecondition, "entries %s are not stored; they may have been garbage collected", pr.entries)
+			}
+		}
+		adv := schema.Advertisement{
+			PreviousID: head,
+			Provider:   d.h.ID().String(),
+			Addresses:  d.retrievalAddrs,
+			Entries:    pr.entries,
+			ContextID:  pr.contextID,
+			Metadata:   pr.metadata,
+			IsRm:       pr.removed,
+		}
+		if err := d.signer(&adv); err != nil {
+			logger.Errorw("Failed to sign ad", "err", err)
+			return nil, 0, status.Errorf(codes.Internal, "failed to sign ad: %v", err)
+		}
+		n, err := adv.ToNode()
+		if err != nil {
+			logger.Errorw("Failed to create IPLD ad node", "err", err)
+			return nil, 0, status.Errorf(codes.Internal, "failed to create ad IPLD node: %v", err)
+		}
+		link, err := d.ls.Store(ipld.LinkContext{Ctx: ctx}, linkPrototype, n)
+		if err != nil {
+			logger.Errorw("Failed to store ad node", "err", err)
+			return nil, 0, status.Errorf(codes.Internal, "failed to store ad IPLD node: %v", err)
+		}
+		state, err := nextContextState(prevState, link, &adv)
+		if err != nil {
+			return nil, 0, status.Errorf(codes.Internal, "failed to create context state: %v", err)
+		}
+		states[string(pr.contextID)] = state
+		links = append(links, link)
+		head = link
 	}
-	n, err := schema.Advertisement{
-		PreviousID: previous,
-		Provider:   d.h.ID().String(),
-		Addresses:  d.retrievalAddrs,
-		Entries:    entries,
-		ContextID:  ad.GetContextId(),
-		Metadata:   ad.GetMetadata(),
-		IsRm:       ad.GetRemoved(),
-	}.ToNode()
+
+	headStates := make([]*depute.ContextState, 0, len(contextIDs))
+	for _, id := range contextIDs {
+		headStates = append(headStates, states[id])
+	}
+	if err := d.setLatestAdvertisementLink(ctx, previous, head, headStates...); err != nil {
+		logger.Errorw("Failed to set latest ad link", "link", head.String(), "err", err)
+		if errors.Is(err, errHeadChanged) {
+			return nil, 0, status.Errorf(codes.FailedPrecondition, "failed to set latest ad link: %v", err)
+		}
+		return nil, 0, status.Errorf(codes.Internal, "failed to set latest ad link: %v", err)
+	}
+	return links, d.headSeq, nil
+}
+
+func (d *Depute) BatchPublish(ctx context.Context, req *depute.BatchPublish_Request) (*depute.BatchPublish_Response, error) {
+	if len(req.GetAdvertisements()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no advertisements")
+	}
+	// Validate all the advertisements before publishing any.
+	prs := make([]*publishRequest, 0, len(req.GetAdvertisements()))
+	for i, ad := range req.GetAdvertisements() {
+		var expectedPrevious *depute.Link
+		if i == 0 {
+			expectedPrevious = req.ExpectedPrevious
+		}
+		pr, err := newPublishRequest(fmt.Sprintf("advertisements[%d]", i), ad, expectedPrevious)
+		if err != nil {
+			return nil, err
+		}
+		pr.skipAnnounce = req.GetSkipAnnounce()
+		prs = append(prs, pr)
+	}
+	links, err := d.publishBatch(ctx, prs)
 	if err != nil {
-		logger.Errorw("Failed to create IPLD ad node", "err", err)
-		return nil, status.Errorf(codes.Internal, "failed to create ad IPLD node: %v", err)
+		return nil, err
+	}
+	resp := depute.BatchPublish_Response{
+		Links: make([]*depute.Link, 0, len(links)),
+	}
+	for _, link := range links {
+		var l depute.Link
+		if err := l.Marshal(link); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal link: %v", err)
+		}
+		resp.Links = append(resp.Links, &l)
 	}
-	link, err := d.ls.Store(ipld.LinkContext{Ctx: ctx}, linkPrototype, n)
+	return &resp, nil
+}
+
+// UpdateMetadata publishes an advertisement that changes the metadata of a
+// live context ID, keeping its entries.
+func (d *Depute) UpdateMetadata(ctx context.Context, req *depute.UpdateMetadata_Request) (*depute.UpdateMetadata_Response, error) {
+	pr, err := newPublishRequest("", &depute.Advertisement{
+		ContextId:     req.GetContextId(),
+		Metadata:      req.GetMetadata(),
+		TypedMetadata: req.GetTypedMetadata(),
+	}, req.ExpectedPrevious)
 	if err != nil {
-		logger.Errorw("Failed to store ad node", "err", err)
-		return nil, status.Errorf(codes.Internal, "failed to store ad IPLD node: %v", err)
+		return nil, err
 	}
-	if err := d.setLatestAdvertisementLink(ctx, link); err != nil {
-		logger.Errorw("Failed to set latest ad link", "link", link.String(), "err", err)
-		return nil, status.Errorf(codes.Internal, "failed to set latest ad link: %v", err)
+	link, err := d.publish(ctx, pr)
+	if err != nil {
+		return nil, err
 	}
-	adCid := link.(cidlink.Link).Cid
-	announce.Send(ctx, adCid, d.publishAddrs, d.senders...)
-	logger.Infow("Published advertisement", "link", link.String())
 	var l depute.Link
 	if err := l.Marshal(link); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to marshal link: %v", err)
 	}
-	return &depute.Publish_Response{
+	return &depute.UpdateMetadata_Response{
 		Link: &l,
 	}, nil
 }
 
+// RemoveContext publishes removal advertisements for the given live context
+// IDs, or for all the live context IDs with the given prefix, in a single
+// update of the chain head.
+func (d *Depute) RemoveContext(ctx context.Context, req *depute.RemoveContext_Request) (*depute.RemoveContext_Response, error) {
+	contextIDs := req.GetContextIds()
+	switch {
+	case len(contextIDs) != 0 && req.Prefix != nil:
+		return nil, status.Error(codes.InvalidArgument, "context IDs and prefix are mutually exclusive")
+	case req.Prefix != nil:
+		if len(req.Prefix) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "prefix must not be empty")
+		}
+		err := d.listContextStates(ctx, req.Prefix, func(state *depute.ContextState) (bool, error) {
+			if state.Removed {
+				return true, nil
+			}
+			if len(contextIDs) == maxRemoveContextIDs {
+				return false, status.Errorf(codes.FailedPrecondition, "more than %d live context IDs have prefix %x; remove them by longer prefixes", maxRemoveContextIDs, req.Prefix)
+			}
+			contextIDs = append(contextIDs, state.ContextId)
+			return true, nil
+		})
+		if err != nil {
+			if status.Code(err) != codes.Unknown {
+				return nil, err
+			}
+			logger.Errorw("Failed to list context states", "err", err)
+			return nil, status.Errorf(codes.Internal, "failed to list context states: %v", err)
+		}
+		if len(contextIDs) == 0 {
+			return nil, status.Errorf(codes.NotFound, "no live context ID has prefix %x", req.Prefix)
+		}
+	case len(contextIDs) == 0:
+		return nil, status.Error(codes.InvalidArgument, "no context IDs or prefix")
+	case len(contextIDs) > maxRemoveContextIDs:
+		return nil, status.Errorf(codes.InvalidArgument, "%d context IDs exceed maximum of %d", len(contextIDs), maxRemoveContextIDs)
+	default:
+		var violations []*rpc.BadRequest_FieldViolation
+		seen := make(map[string]struct{}, len(contextIDs))
+		for i, id := range contextIDs {
+			field := fmt.Sprintf("context_ids[%d]", i)
+			if _, ok := seen[string(id)]; ok {
+				violations = append(violations, &rpc.BadRequest_FieldViolation{
+					Field:       field,
+					Description: "duplicate context ID",
+				})
+			}
+			seen[string(id)] = struct{}{}
+			for _, v := range validateAdvertisement(field, &depute.Advertisement{ContextId: id, Removed: true}) {
+				// Report context ID violations against the list element.
+				v.Field = field
+				violations = append(violations, v)
+			}
+		}
+		if len(violations) != 0 {
+			return nil, invalidArgument(violations)
+		}
+	}
+
+	prs := make([]*publishRequest, 0, len(contextIDs))
+	for i, id := range contextIDs {
+		var expectedPrevious *depute.Link
+		if i == 0 {
+			expectedPrevious = req.ExpectedPrevious
+		}
+		pr, err := newPublishRequest("", &depute.Advertisement{ContextId: id, Removed: true}, expectedPrevious)
+		if err != nil {
+			return nil, err
+		}
+		pr.requireLive = true
+		prs = append(prs, pr)
+	}
+	links, err := d.publishBatch(ctx, prs)
+	if err != nil {
+		return nil, err
+	}
+	resp := depute.RemoveContext_Response{
+		Contexts: make([]*depute.ContextState, 0, len(links)),
+	}
+	for i, link := range links {
+		state := &depute.ContextState{
+			ContextId:     contextIDs[i],
+			Advertisement: &depute.Link{},
+			Removed:       true,
+		}
+		if err := state.Advertisement.Marshal(link); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal link: %v", err)
+		}
+		resp.Contexts = append(resp.Contexts, state)
+	}
+	return &resp, nil
+}
+
+func (d *Depute) GetHead(ctx context.Context, _ *depute.GetHead_Request) (*depute.GetHead_Response, error) {
+	head, err := d.getLatestAdvertisementLink(ctx)
+	if err != nil {
+		logger.Errorw("Failed to get latest ad link", "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to get latest ad link: %v", err)
+	}
+	var resp depute.GetHead_Response
+	if head != nil {
+		var l depute.Link
+		if err := l.Marshal(head); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal link: %v", err)
+		}
+		resp.Link = &l
+	}
+	return &resp, nil
+}
+
+func (d *Depute) GetAdvertisement(ctx context.Context, req *depute.GetAdvertisement_Request) (*depute.GetAdvertisement_Response, error) {
+	if len(req.GetLink().GetValue()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no link")
+	}
+	link, err := req.GetLink().Unmarshal()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid link: %v", err)
+	}
+	ad, err := d.loadAdvertisement(ctx, link)
+	if err != nil {
+		if errors.Is(err, datastore.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no advertisement found for link %s", link)
+		}
+		logger.Errorw("Failed to load ad", "link", link.String(), "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to load ad: %v", err)
+	}
+	pad, err := toAdvertisement(link, ad)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert ad: %v", err)
+	}
+	return &depute.GetAdvertisement_Response{
+		Advertisement: pad,
+	}, nil
+}
+
+func (d *Depute) ListAdvertisements(req *depute.ListAdvertisements_Request, stream depute.Publisher_ListAdvertisementsServer) error {
+	ctx := stream.Context()
+	var start ipld.Link
+	if req.Start != nil {
+		var err error
+		if start, err = req.GetStart().Unmarshal(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid start link: %v", err)
+		}
+	} else {
+		var err error
+		if start, err = d.getLatestAdvertisementLink(ctx); err != nil {
+			logger.Errorw("Failed to get latest ad link", "err", err)
+			return status.Errorf(codes.Internal, "failed to get latest ad link: %v", err)
+		}
+	}
+	var stopAt ipld.Link
+	if req.StopAt != nil {
+		var err error
+		if stopAt, err = req.GetStopAt().Unmarshal(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid stop at link: %v", err)
+		}
+	}
+
+	var count uint64
+	err := d.walkAdvertisements(ctx, start, func(l ipld.Link, ad *schema.Advertisement) (bool, error) {
+		if stopAt != nil && linkCid(l) == linkCid(stopAt) {
+			return false, nil
+		}
+		if req.ContextId != nil && !bytes.Equal(req.ContextId, ad.ContextID) {
+			return true, nil
+		}
+		pad, err := toAdvertisement(l, ad)
+		if err != nil {
+			return false, status.Errorf(codes.Internal, "failed to convert ad: %v", err)
+		}
+		if err := stream.Send(&depute.ListAdvertisements_Response{Advertisement: pad}); err != nil {
+			return false, err
+		}
+		count++
+		return req.Limit == 0 || count < req.Limit, nil
+	})
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, datastore.ErrNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case status.Code(err) != codes.Unknown:
+		return err
+	default:
+		logger.Errorw("Failed to list ads", "err", err)
+		return status.Errorf(codes.Internal, "failed to list ads: %v", err)
+	}
+}
+
+func (d *Depute) GetEntries(req *depute.GetEntries_Request, stream depute.Publisher_GetEntriesServer) error {
+	if len(req.GetLink().GetValue()) == 0 {
+		return status.Error(codes.InvalidArgument, "no link")
+	}
+	link, err := req.GetLink().Unmarshal()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid link: %v", err)
+	}
+	err = d.walkEntries(stream.Context(), link, func(mh multihash.Multihash) error {
+		return stream.Send(&depute.GetEntries_Response{
+			Multihash: &depute.Multihash{Value: mh},
+		})
+	})
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, datastore.ErrNotFound):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case status.Code(err) != codes.Unknown:
+		return err
+	default:
+		logger.Errorw("Failed to get entries", "link", link.String(), "err", err)
+		return status.Errorf(codes.Internal, "failed to get entries: %v", err)
+	}
+}
+
+func (d *Depute) GetContext(ctx context.Context, req *depute.GetContext_Request) (*depute.GetContext_Response, error) {
+	if len(req.GetContextId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no context ID")
+	}
+	state, err := d.getContextState(ctx, req.GetContextId())
+	if err != nil {
+		logger.Errorw("Failed to get context state", "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to get context state: %v", err)
+	}
+	if state == nil {
+		return nil, status.Error(codes.NotFound, "context ID has not been advertised")
+	}
+	return &depute.GetContext_Response{
+		Context: state,
+	}, nil
+}
+
+func (d *Depute) ListContexts(req *depute.ListContexts_Request, stream depute.Publisher_ListContextsServer) error {
+	err := d.listContextStates(stream.Context(), req.GetPrefix(), func(state *depute.ContextState) (bool, error) {
+		if state.Removed && !req.GetIncludeRemoved() {
+			return true, nil
+		}
+		return true, stream.Send(&depute.ListContexts_Response{Context: state})
+	})
+	switch {
+	case err == nil:
+		return nil
+	case status.Code(err) != codes.Unknown:
+		return err
+	default:
+		logger.Errorw("Failed to list contexts", "err", err)
+		return status.Errorf(codes.Internal, "failed to list contexts: %v", err)
+	}
+}
+
 func (d *Depute) getLatestAdvertisementLink(ctx context.Context) (ipld.Link, error) {
-	v, err := d.ds.Get(ctx, dsKeyLatestAdLink)
+	return readLatestAdvertisementLink(ctx, d.ds)
+}
+
+func readLatestAdvertisementLink(ctx context.Context, r datastore.Read) (ipld.Link, error) {
+	v, err := r.Get(ctx, dsKeyLatestAdLink)
 	switch err {
 	case nil:
 		_, c, err := cid.CidFromBytes(v)
@@ -168,8 +945,121 @@ func (d *Depute) getLatestAdvertisementLink(ctx context.Context) (ipld.Link, err
 	}
 }
 
-func (d *Depute) setLatestAdvertisementLink(ctx context.Context, l ipld.Link) error {
-	return d.ds.Put(ctx, dsKeyLatestAdLink, l.(cidlink.Link).Bytes())
+// setLatestAdvertisementLink persists the given link as the head of the
+// advertisement chain, provided that the persisted head is still previous, and
+// sets it as the publisher root. The given context states are persisted along
+// with the head. The publisher root is only updated once the head is
+// successfully persisted. The caller must hold headLock.
+//
+// The comparison and the update are made within a single transaction when the
+// datastore supports transactions. Otherwise, the updates are written in a
+// single batch.
+func (d *Depute) setLatestAdvertisementLink(ctx context.Context, previous, l ipld.Link, states ...*depute.ContextState) error {
+	return d.updateHead(ctx, previous, l, false, states)
+}
+
+// updateHead implements setLatestAdvertisementLink. If resetContexts is set,
+// the state of the context IDs not in states is removed, so that states
+// replace the state of all context IDs.
+func (d *Depute) updateHead(ctx context.Context, previous, l ipld.Link, resetContexts bool, states []*depute.ContextState) error {
+	var (
+		r      datastore.Read
+		w      datastore.Write
+		commit func(context.Context) error
+	)
+	if tds, ok := d.ds.(datastore.TxnDatastore); ok {
+		txn, err := tds.NewTransaction(ctx, false)
+		if err != nil {
+			return err
+		}
+		defer txn.Discard(ctx)
+		r, w, commit = txn, txn, txn.Commit
+	} else {
+		b, err := d.ds.Batch(ctx)
+		if err != nil {
+			return err
+		}
+		r, w, commit = d.ds, b, b.Commit
+	}
+
+	current, err := readLatestAdvertisementLink(ctx, r)
+	if err != nil {
+		return err
+	}
+	if linkCid(current) != linkCid(previous) {
+		return fmt.Errorf("%w: expected %s, got %s", errHeadChanged, linkString(previous), linkString(current))
+	}
+	if err := w.Put(ctx, dsKeyLatestAdLink, linkCid(l).Bytes()); err != nil {
+		return err
+	}
+	if resetContexts {
+		keep := make(map[datastore.Key]struct{}, len(states))
+		for _, s := range states {
+			keep[contextKey(s.ContextId)] = struct{}{}
+		}
+		results, err := d.ds.Query(ctx, query.Query{
+			Prefix:   dsKeyPrefixContext.String(),
+			KeysOnly: true,
+		})
+		if err != nil {
+			return err
+		}
+		for r := range results.Next() {
+			if r.Error != nil {
+				results.Close()
+				return r.Error
+			}
+			k := datastore.RawKey(r.Key)
+			if _, ok := keep[k]; ok {
+				continue
+			}
+			if err := w.Delete(ctx, k); err != nil {
+				results.Close()
+				return err
+			}
+		}
+		results.Close()
+	}
+	for _, s := range states {
+		if err := putContextState(ctx, w, s); err != nil {
+			return err
+		}
+	}
+	if err := commit(ctx); err != nil {
+		return err
+	}
+	d.headSeq++
+	d.publisher.SetRoot(linkCid(l))
+	return nil
+}
+
+// currentHead returns the advertisement chain head along with its sequence
+// number.
+func (d *Depute) currentHead(ctx context.Context) (ipld.Link, uint64, error) {
+	d.headLock.Lock()
+	defer d.headLock.Unlock()
+	head, err := d.getLatestAdvertisementLink(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return head, d.headSeq, nil
+}
+
+// linkString returns the string form of the given link, or "none" if the link
+// is nil or undefined.
+func linkString(l ipld.Link) string {
+	if c := linkCid(l); c.Defined() {
+		return c.String()
+	}
+	return "none"
+}
+
+// linkCid returns the CID of the given link, or cid.Undef if the link is nil.
+func linkCid(l ipld.Link) cid.Cid {
+	if l == nil {
+		return cid.Undef
+	}
+	return l.(cidlink.Link).Cid
 }
 
 func (d *Depute) Start(_ context.Context) error {
@@ -178,15 +1068,53 @@ func (d *Depute) Start(_ context.Context) error {
 		return err
 	}
 	depute.RegisterPublisherServer(d.server, d)
+	depute.RegisterAdminServer(d.server, d)
 	go func() { _ = d.server.Serve(ln) }()
 	logger.Infow("Server started", "addr", ln.Addr())
+	if d.gcInterval > 0 {
+		d.wg.Add(1)
+		go d.gcLoop()
+		logger.Infow("Scheduled garbage collection", "interval", d.gcInterval, "gracePeriod", d.gcGracePeriod)
+	}
+	if d.announceWindow > 0 {
+		d.wg.Add(1)
+		go d.announceLoop()
+		logger.Infow("Coalescing announcements", "window", d.announceWindow)
+	}
+	if d.reannounceInterval > 0 {
+		d.wg.Add(1)
+		go d.reannounceLoop()
+		logger.Infow("Scheduled reannouncement", "interval", d.reannounceInterval)
+	}
 	return nil
 }
 
+// closingContext returns a context that is cancelled on Shutdown.
+func (d *Depute) closingContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-d.closing:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
 func (d *Depute) Shutdown(_ context.Context) error {
+	// Running handlers are cancelled and waited for before background tasks
+	// are stopped, so that they start no more tasks.
 	d.server.Stop()
+	close(d.closing)
+	d.wg.Wait()
 	pErr := d.publisher.Close()
 	dsErr := d.ds.Close()
+	if d.dsCloser != nil {
+		if err := d.dsCloser.Close(); err != nil && dsErr == nil {
+			dsErr = err
+		}
+	}
 	hErr := d.h.Close()
 	switch {
 	case hErr != nil:
@@ -0,0 +1,179 @@
+package depute
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/gogo/status"
+	"github.com/ipld/go-ipld-prime"
+	depute "github.com/ipni/depute/api/v0"
+	"github.com/ipni/go-libipni/ingest/schema"
+	"github.com/ipni/go-libipni/metadata"
+	"google.golang.org/grpc/codes"
+)
+
+func testMetadata(t *testing.T) []byte {
+	t.Helper()
+	bitswap := metadata.Default.New(metadata.Bitswap{})
+	md, err := bitswap.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return md
+}
+
+// chainLinks returns the links to the advertisements of the chain, from the
+// head.
+func chainLinks(t *testing.T, d *Depute) []ipld.Link {
+	t.Helper()
+	ctx := context.Background()
+	head, err := d.getLatestAdvertisementLink(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var links []ipld.Link
+	if err := d.walkAdvertisements(ctx, head, func(l ipld.Link, _ *schema.Advertisement) (bool, error) {
+		links = append(links, l)
+		return true, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return links
+}
+
+func TestPublishConcurrently(t *testing.T) {
+	d := newTestDepute(t)
+	md := testMetadata(t)
+	entries := notifyContent(t, d, &testSource{ctx: context.Background(), mhs: testMultihashes(t, 3)})
+
+	const publishes = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, publishes)
+	for i := 0; i < publishes; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := d.Publish(context.Background(), &depute.Publish_Request{
+				Advertisement: &depute.Advertisement{
+					ContextId: []byte(fmt.Sprint("fish", i)),
+					Metadata:  md,
+					Entries:   entries,
+				},
+			})
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Every advertisement is in a single linear chain.
+	links := chainLinks(t, d)
+	if len(links) != publishes {
+		t.Fatalf("expected a chain of %d advertisements; got %d", publishes, len(links))
+	}
+	seen := make(map[ipld.Link]struct{}, len(links))
+	for _, l := range links {
+		if _, ok := seen[l]; ok {
+			t.Fatalf("advertisement %s is chained more than once", l)
+		}
+		seen[l] = struct{}{}
+	}
+}
+
+func TestPublishExpectedPrevious(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDepute(t)
+	md := testMetadata(t)
+	entries := notifyContent(t, d, &testSource{ctx: ctx, mhs: testMultihashes(t, 3)})
+	publish := func(contextID string, expectedPrevious *depute.Link) (*depute.Link, error) {
+		resp, err := d.Publish(ctx, &depute.Publish_Request{
+			Advertisement: &depute.Advertisement{
+				ContextId: []byte(contextID),
+				Metadata:  md,
+				Entries:   entries,
+			},
+			ExpectedPrevious: expectedPrevious,
+		})
+		return resp.GetLink(), err
+	}
+
+	// An empty link expects an empty chain.
+	first, err := publish("fish", &depute.Link{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := publish("lobster", &depute.Link{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for an empty chain; got %v", err)
+	}
+	second, err := publish("lobster", first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := publish("crab", first); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for a stale head; got %v", err)
+	}
+
+	links := chainLinks(t, d)
+	if len(links) != 2 {
+		t.Fatalf("expected a chain of 2 advertisements; got %d", len(links))
+	}
+	head, err := second.Unmarshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if links[0] != head {
+		t.Fatalf("expected head %s; got %s", head, links[0])
+	}
+}
+
+func TestPublishMetadataOnly(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDepute(t)
+	md := testMetadata(t)
+	publish := func(entries *depute.Link) error {
+		_, err := d.Publish(ctx, &depute.Publish_Request{
+			Advertisement: &depute.Advertisement{
+				ContextId: []byte("fish"),
+				Metadata:  md,
+				Entries:   entries,
+			},
+		})
+		return err
+	}
+
+	// An advertisement with no entries only updates the metadata of a live
+	// context ID.
+	if err := publish(nil); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for a context ID not advertised; got %v", err)
+	}
+	entries := notifyContent(t, d, &testSource{ctx: ctx, mhs: testMultihashes(t, 3)})
+	if err := publish(entries); err != nil {
+		t.Fatal(err)
+	}
+	if err := publish(nil); err != nil {
+		t.Fatal(err)
+	}
+	state, err := d.getContextState(ctx, []byte("fish"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.Removed || string(state.GetEntries().GetValue()) != string(entries.GetValue()) {
+		t.Fatalf("expected live context ID to keep its entries; got %v", state)
+	}
+
+	if _, err := d.Publish(ctx, &depute.Publish_Request{
+		Advertisement: &depute.Advertisement{ContextId: []byte("fish"), Removed: true},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := publish(nil); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound for a removed context ID; got %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package depute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+
+	hamt "github.com/ipld/go-ipld-adl-hamt"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
+	"github.com/ipld/go-ipld-prime/node/bindnode"
+	"github.com/ipni/go-libipni/ingest/schema"
+	"github.com/multiformats/go-multihash"
+)
+
+// walkEntries calls f for each multihash in the advertisement entries with the
+// given link, stopping at the first error returned by f. The entries may be
+// stored either as a chain of schema.EntryChunk or as an IPLD HAMT.
+func (d *Depute) walkEntries(ctx context.Context, l ipld.Link, f func(multihash.Multihash) error) error {
+	n, err := d.ls.Load(ipld.LinkContext{Ctx: ctx}, l, basicnode.Prototype.Any)
+	if err != nil {
+		return fmt.Errorf("cannot load entries %s: %w", l, err)
+	}
+	if isHAMT(n) {
+		return d.walkHamtEntries(n, f)
+	}
+	for {
+		chunk, err := schema.UnwrapEntryChunk(n)
+		if err != nil {
+			return fmt.Errorf("cannot decode entry chunk %s: %w", l, err)
+		}
+		for _, mh := range chunk.Entries {
+			if err := f(mh); err != nil {
+				return err
+			}
+		}
+		if chunk.Next == nil {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		l = chunk.Next
+		n, err = d.ls.Load(ipld.LinkContext{Ctx: ctx}, l, schema.EntryChunkPrototype)
+		if err != nil {
+			return fmt.Errorf("cannot load entry chunk %s: %w", l, err)
+		}
+	}
+}
+
+// walkHamtEntries calls f for each multihash key of the HAMT with the given
+// root node.
+func (d *Depute) walkHamtEntries(n ipld.Node, f func(multihash.Multihash) error) error {
+	nb := hamt.HashMapRootPrototype.NewBuilder()
+	if err := nb.AssignNode(n); err != nil {
+		return fmt.Errorf("cannot decode HAMT root: %w", err)
+	}
+	root, ok := bindnode.Unwrap(nb.Build()).(*hamt.HashMapRoot)
+	if !ok {
+		return errors.New("cannot unwrap HAMT root")
+	}
+	hn := hamt.Node{HashMapRoot: *root}.WithLinking(*d.ls, schema.Linkproto)
+	for it := hn.MapIterator(); !it.Done(); {
+		k, _, err := it.Next()
+		if err != nil {
+			return err
+		}
+		// HAMT keys are presented as strings holding the key bytes.
+		key, err := k.AsString()
+		if err != nil {
+			return err
+		}
+		if err := f(multihash.Multihash(key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isHAMT reports whether the given node is the root of an IPLD HAMT.
+func isHAMT(n ipld.Node) bool {
+	h, err := n.LookupByString("hamt")
+	return err == nil && h != nil
+}
+
+// countingLinkSystem returns a copy of the given link system that counts the
+// blocks it stores in n.
+func countingLinkSystem(ls ipld.LinkSystem, n *atomic.Uint64) ipld.LinkSystem {
+	open := ls.StorageWriteOpener
+	ls.StorageWriteOpener = func(lctx ipld.LinkContext) (io.Writer, ipld.BlockWriteCommitter, error) {
+		w, commit, err := open(lctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		return w, func(l ipld.Link) error {
+			if err := commit(l); err != nil {
+				return err
+			}
+			n.Add(1)
+			return nil
+		}, nil
+	}
+	return ls
+}
@@ -0,0 +1,122 @@
+package depute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gogo/status"
+	"github.com/ipfs/go-cid"
+	carv2 "github.com/ipld/go-car/v2"
+	carstorage "github.com/ipld/go-car/v2/storage"
+	"github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	depute "github.com/ipni/depute/api/v0"
+	"github.com/ipni/go-libipni/ingest/schema"
+	"google.golang.org/grpc/codes"
+)
+
+// exportChunkSize is the maximum number of bytes of CAR data sent per Export
+// response.
+const exportChunkSize = 1 << 20
+
+func (d *Depute) Export(req *depute.Export_Request, stream depute.Admin_ExportServer) error {
+	ctx := stream.Context()
+	var stopAt ipld.Link
+	if req.StopAt != nil {
+		var err error
+		if stopAt, err = req.GetStopAt().Unmarshal(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid stop at link: %v", err)
+		}
+	}
+	head, err := d.getLatestAdvertisementLink(ctx)
+	if err != nil {
+		logger.Errorw("Failed to get latest ad link", "err", err)
+		return status.Errorf(codes.Internal, "failed to get latest ad link: %v", err)
+	}
+	if head == nil {
+		return status.Error(codes.FailedPrecondition, "no advertisements to export")
+	}
+
+	// CARv2 is written at random, so the CAR is staged in a temporary file
+	// before it is sent.
+	f, err := os.CreateTemp("", "depute-export-*.car")
+	if err != nil {
+		logger.Errorw("Failed to create export file", "err", err)
+		return status.Errorf(codes.Internal, "failed to create export file: %v", err)
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+	if err := d.exportCar(ctx, f, head, stopAt, req.GetExcludeEntries()); err != nil {
+		if status.Code(err) != codes.Unknown {
+			return err
+		}
+		logger.Errorw("Failed to export CAR", "err", err)
+		return status.Errorf(codes.Internal, "failed to export CAR: %v", err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return status.Errorf(codes.Internal, "failed to read export file: %v", err)
+	}
+	buf := make([]byte, exportChunkSize)
+	for {
+		n, err := io.ReadFull(f, buf)
+		if n != 0 {
+			if err := stream.Send(&depute.Export_Response{Data: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		switch {
+		case err == nil:
+		case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+			return nil
+		default:
+			return status.Errorf(codes.Internal, "failed to read export file: %v", err)
+		}
+	}
+}
+
+// exportCar writes the advertisement chain from the given head, and the
+// entries of each advertisement unless excluded, to f as a CARv2 with the head
+// as root. The chain is written up to the given stop advertisement, exclusive,
+// if non-nil.
+func (d *Depute) exportCar(ctx context.Context, f *os.File, head, stopAt ipld.Link, excludeEntries bool) error {
+	car, err := carstorage.NewWritable(f, []cid.Cid{head.(cidlink.Link).Cid}, carv2.UseWholeCIDs(true))
+	if err != nil {
+		return err
+	}
+	var ads, blocks int
+	visited := make(map[string]struct{})
+	put := func(l ipld.Link, data []byte) error {
+		blocks++
+		return car.Put(ctx, l.Binary(), data)
+	}
+	err = d.walkAdvertisements(ctx, head, func(l ipld.Link, ad *schema.Advertisement) (bool, error) {
+		if stopAt != nil && linkCid(l) == linkCid(stopAt) {
+			return false, nil
+		}
+		data, err := d.ls.LoadRaw(ipld.LinkContext{Ctx: ctx}, l)
+		if err != nil {
+			return false, fmt.Errorf("cannot load advertisement %s: %w", l, err)
+		}
+		if err := put(l, data); err != nil {
+			return false, err
+		}
+		ads++
+		if excludeEntries || ad.Entries == nil {
+			return true, nil
+		}
+		return true, d.walkBlocks(ctx, ad.Entries, visited, put)
+	})
+	if err != nil {
+		return err
+	}
+	if err := car.Finalize(); err != nil {
+		return err
+	}
+	logger.Infow("Exported CAR", "head", head.String(), "ads", ads, "blocks", blocks)
+	return nil
+}
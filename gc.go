@@ -0,0 +1,404 @@
+package depute
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gogo/status"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/datamodel"
+	"github.com/ipld/go-ipld-prime/node/basicnode"
+	depute "github.com/ipni/depute/api/v0"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// dsKeyPrefixLinks is the datastore key prefix under which the link system
+	// stores blocks, keyed by binary CID.
+	dsKeyPrefixLinks = datastore.NewKey("ls")
+	// dsKeyPrefixUnreachable is the datastore key prefix under which the time
+	// at which garbage collection first found a block unreachable is stored,
+	// keyed by the hex encoded block key.
+	dsKeyPrefixUnreachable = datastore.NewKey("depute/gc/unreachable")
+)
+
+func linkKey(l ipld.Link) datastore.Key {
+	return dsKeyPrefixLinks.Child(datastore.NewKey(l.Binary()))
+}
+
+func unreachableKey(k string) datastore.Key {
+	return dsKeyPrefixUnreachable.ChildString(hex.EncodeToString([]byte(k)))
+}
+
+func (d *Depute) CollectGarbage(ctx context.Context, req *depute.CollectGarbage_Request) (*depute.CollectGarbage_Response, error) {
+	res, err := d.collectGarbage(ctx, req.GetDryRun())
+	if err != nil {
+		if status.Code(err) != codes.Unknown {
+			return nil, err
+		}
+		logger.Errorw("Failed to collect garbage", "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to collect garbage: %v", err)
+	}
+	return res, nil
+}
+
+// gcLoop collects garbage at the configured interval until Shutdown.
+func (d *Depute) gcLoop() {
+	defer d.wg.Done()
+	ticker := time.NewTicker(d.gcInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.closing:
+			return
+		case <-ticker.C:
+			ctx, cancel := d.closingContext()
+			if _, err := d.collectGarbage(ctx, false); err != nil {
+				logger.Errorw("Failed to collect garbage", "err", err)
+			}
+			cancel()
+		}
+	}
+}
+
+// collectGarbage deletes the blocks that are neither reachable from the
+// advertisement chain head, from content sessions, nor from the entries stored
+// by running streams, once they have been found unreachable for the grace
+// period.
+//
+// Blocks are marked without blocking publication or streams. Blocks published
+// or stored by streams meanwhile are marked before sweeping, while holding the
+// head lock and blocking stores of entries.
+func (d *Depute) collectGarbage(ctx context.Context, dryRun bool) (*depute.CollectGarbage_Response, error) {
+	if !d.gcLock.TryLock() {
+		return nil, status.Error(codes.Aborted, "garbage collection is in progress")
+	}
+	defer d.gcLock.Unlock()
+	start := time.Now()
+
+	marked := make(map[string]struct{})
+	head, err := d.getLatestAdvertisementLink(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get latest advertisement link: %w", err)
+	}
+	roots, err := d.contentSessionLinks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if head != nil {
+		roots = append(roots, head)
+	}
+	roots = append(roots, d.trackedLinks()...)
+	for _, root := range roots {
+		if err := d.markReachable(ctx, root, marked); err != nil {
+			return nil, err
+		}
+	}
+
+	unreachableSince, err := d.unreachableTimes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type block struct {
+		key  string
+		size int
+	}
+	var res depute.CollectGarbage_Response
+	var candidates []block
+	b, err := d.ds.Batch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	results, err := d.ds.Query(ctx, query.Query{
+		Prefix:       dsKeyPrefixLinks.String(),
+		KeysOnly:     true,
+		ReturnsSizes: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	cutoff := start.Add(-d.gcGracePeriod)
+	for r := range results.Next() {
+		if r.Error != nil {
+			results.Close()
+			return nil, r.Error
+		}
+		since, found := unreachableSince[r.Key]
+		delete(unreachableSince, r.Key)
+		if _, ok := marked[r.Key]; ok {
+			res.Reachable++
+			if found && !dryRun {
+				// The block is reachable again.
+				if err := b.Delete(ctx, unreachableKey(r.Key)); err != nil {
+					results.Close()
+					return nil, err
+				}
+			}
+			continue
+		}
+		switch {
+		case !found:
+			res.Unreachable++
+			if !dryRun {
+				if err := b.Put(ctx, unreachableKey(r.Key), []byte(strconv.FormatInt(start.Unix(), 10))); err != nil {
+					results.Close()
+					return nil, err
+				}
+			}
+		case since.After(cutoff):
+			res.Unreachable++
+		default:
+			candidates = append(candidates, block{r.Key, r.Size})
+		}
+	}
+	results.Close()
+	if !dryRun {
+		// Forget about blocks that no longer exist.
+		for k := range unreachableSince {
+			if err := b.Delete(ctx, unreachableKey(k)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(candidates) != 0 {
+		d.headLock.Lock()
+		defer d.headLock.Unlock()
+		d.storesLock.Lock()
+		defer d.storesLock.Unlock()
+		newHead, err := d.getLatestAdvertisementLink(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get latest advertisement link: %w", err)
+		}
+		if newHead != nil && linkCid(newHead) != linkCid(head) {
+			if err := d.markReachable(ctx, newHead, marked); err != nil {
+				return nil, err
+			}
+		}
+		for _, l := range d.trackedLinks() {
+			if err := d.markReachable(ctx, l, marked); err != nil {
+				return nil, err
+			}
+		}
+		for _, c := range candidates {
+			if _, ok := marked[c.key]; ok {
+				res.Reachable++
+				continue
+			}
+			res.Swept++
+			res.SweptBytes += uint64(c.size)
+			if dryRun {
+				continue
+			}
+			if err := b.Delete(ctx, datastore.RawKey(c.key)); err != nil {
+				return nil, err
+			}
+			if err := b.Delete(ctx, unreachableKey(c.key)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if !dryRun {
+		if err := b.Commit(ctx); err != nil {
+			return nil, err
+		}
+	}
+	logger.Infow("Collected garbage", "dryRun", dryRun, "reachable", res.Reachable, "unreachable", res.Unreachable,
+		"swept", res.Swept, "sweptBytes", res.SweptBytes, "elapsed", time.Since(start))
+	return &res, nil
+}
+
+// entriesTracker records the blocks stored by a running stream.
+type entriesTracker struct {
+	mu    sync.Mutex
+	links []ipld.Link
+}
+
+// trackEntries returns a copy of the given link system that records the blocks
+// it stores as garbage collection roots, until the returned function is
+// called. Streams track the entries they store so that the entries are not
+// swept before the stream is done, however long it runs.
+func (d *Depute) trackEntries(ls ipld.LinkSystem) (ipld.LinkSystem, func()) {
+	t := &entriesTracker{}
+	d.trackersLock.Lock()
+	d.trackers[t] = struct{}{}
+	d.trackersLock.Unlock()
+	open := ls.StorageWriteOpener
+	ls.StorageWriteOpener = func(lctx ipld.LinkContext) (io.Writer, ipld.BlockWriteCommitter, error) {
+		w, commit, err := open(lctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		return w, func(l ipld.Link) error {
+			d.storesLock.RLock()
+			defer d.storesLock.RUnlock()
+			if err := commit(l); err != nil {
+				return err
+			}
+			t.mu.Lock()
+			t.links = append(t.links, l)
+			t.mu.Unlock()
+			return nil
+		}, nil
+	}
+	return ls, func() {
+		d.trackersLock.Lock()
+		delete(d.trackers, t)
+		d.trackersLock.Unlock()
+	}
+}
+
+// trackedLinks returns the links to the blocks stored by running streams.
+func (d *Depute) trackedLinks() []ipld.Link {
+	d.trackersLock.Lock()
+	defer d.trackersLock.Unlock()
+	var links []ipld.Link
+	for t := range d.trackers {
+		t.mu.Lock()
+		links = append(links, t.links...)
+		t.mu.Unlock()
+	}
+	return links
+}
+
+// markReachable marks the blocks reachable from the given link that are
+// stored locally, skipping those already marked.
+func (d *Depute) markReachable(ctx context.Context, root ipld.Link, marked map[string]struct{}) error {
+	return d.walkBlocks(ctx, root, marked, nil)
+}
+
+// walkBlocks calls f, if non-nil, with the link and data of each block
+// reachable from the given link that is stored locally, skipping the blocks
+// whose keys are in visited. The keys of the blocks walked are added to
+// visited.
+func (d *Depute) walkBlocks(ctx context.Context, root ipld.Link, visited map[string]struct{}, f func(ipld.Link, []byte) error) error {
+	stack := []ipld.Link{root}
+	for len(stack) != 0 {
+		l := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		k := linkKey(l).String()
+		if _, ok := visited[k]; ok {
+			continue
+		}
+		visited[k] = struct{}{}
+		n, data, err := d.ls.LoadPlusRaw(ipld.LinkContext{Ctx: ctx}, l, basicnode.Prototype.Any)
+		if err != nil {
+			if errors.Is(err, datastore.ErrNotFound) {
+				// Links such as schema.NoEntries refer to blocks that are not
+				// stored locally.
+				continue
+			}
+			return fmt.Errorf("cannot load block %s: %w", l, err)
+		}
+		if f != nil {
+			if err := f(l, data); err != nil {
+				return err
+			}
+		}
+		if err := appendLinks(n, &stack); err != nil {
+			return fmt.Errorf("cannot traverse block %s: %w", l, err)
+		}
+	}
+	return nil
+}
+
+// appendLinks appends the links within the given node to links.
+func appendLinks(n ipld.Node, links *[]ipld.Link) error {
+	switch n.Kind() {
+	case datamodel.Kind_Link:
+		l, err := n.AsLink()
+		if err != nil {
+			return err
+		}
+		*links = append(*links, l)
+	case datamodel.Kind_Map:
+		for it := n.MapIterator(); !it.Done(); {
+			_, v, err := it.Next()
+			if err != nil {
+				return err
+			}
+			if err := appendLinks(v, links); err != nil {
+				return err
+			}
+		}
+	case datamodel.Kind_List:
+		for it := n.ListIterator(); !it.Done(); {
+			_, v, err := it.Next()
+			if err != nil {
+				return err
+			}
+			if err := appendLinks(v, links); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// contentSessionLinks returns the links to the entries of content sessions.
+func (d *Depute) contentSessionLinks(ctx context.Context) ([]ipld.Link, error) {
+	results, err := d.ds.Query(ctx, query.Query{
+		Prefix: dsKeyPrefixSession.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close()
+	var links []ipld.Link
+	for r := range results.Next() {
+		if r.Error != nil {
+			return nil, r.Error
+		}
+		var s depute.ContentSession
+		if err := proto.Unmarshal(r.Value, &s); err != nil {
+			return nil, fmt.Errorf("cannot decode content session: %w", err)
+		}
+		if len(s.GetLink().GetValue()) == 0 {
+			continue
+		}
+		l, err := s.GetLink().Unmarshal()
+		if err != nil {
+			return nil, fmt.Errorf("invalid content session link: %w", err)
+		}
+		links = append(links, l)
+	}
+	return links, nil
+}
+
+// unreachableTimes returns the time at which each block key was first found
+// unreachable.
+func (d *Depute) unreachableTimes(ctx context.Context) (map[string]time.Time, error) {
+	results, err := d.ds.Query(ctx, query.Query{
+		Prefix: dsKeyPrefixUnreachable.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer results.Close()
+	times := make(map[string]time.Time)
+	for r := range results.Next() {
+		if r.Error != nil {
+			return nil, r.Error
+		}
+		k, err := hex.DecodeString(datastore.RawKey(r.Key).BaseNamespace())
+		if err != nil {
+			return nil, fmt.Errorf("invalid unreachable block key: %w", err)
+		}
+		secs, err := strconv.ParseInt(string(r.Value), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid unreachable block time: %w", err)
+		}
+		times[string(k)] = time.Unix(secs, 0)
+	}
+	return times, nil
+}
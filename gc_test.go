@@ -0,0 +1,172 @@
+package depute
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/gogo/status"
+	depute "github.com/ipni/depute/api/v0"
+	"github.com/ipni/go-libipni/metadata"
+	"github.com/multiformats/go-multihash"
+	"google.golang.org/grpc/codes"
+)
+
+// testSource is a NotifyContent stream that sends the given options, if any,
+// followed by the given multihashes, calling onRecv, if set, before each one.
+// If err is set, the stream fails with it once the multihashes are sent.
+type testSource struct {
+	ctx     context.Context
+	options *depute.NotifyContent_Options
+	mhs     []multihash.Multihash
+	onRecv  func(i int)
+	err     error
+	next    int
+}
+
+func (s *testSource) Recv() (*depute.NotifyContent_Request, error) {
+	if s.options != nil {
+		options := s.options
+		s.options = nil
+		return &depute.NotifyContent_Request{Options: options}, nil
+	}
+	if s.next == len(s.mhs) {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	}
+	if s.onRecv != nil {
+		s.onRecv(s.next)
+	}
+	mh := s.mhs[s.next]
+	s.next++
+	return &depute.NotifyContent_Request{Multihash: &depute.Multihash{Value: mh}}, nil
+}
+
+func (s *testSource) Context() context.Context {
+	return s.ctx
+}
+
+func newTestDepute(t *testing.T, o ...Option) *Depute {
+	t.Helper()
+	d, err := New(append([]Option{WithNoPubsubAnnounce(), WithGrpcListenAddr("127.0.0.1:0")}, o...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = d.Shutdown(context.Background()) })
+	return d
+}
+
+func testMultihashes(t *testing.T, n int) []multihash.Multihash {
+	t.Helper()
+	mhs := make([]multihash.Multihash, 0, n)
+	for i := 0; i < n; i++ {
+		mh, err := multihash.Sum([]byte(fmt.Sprint(i)), multihash.SHA2_256, -1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mhs = append(mhs, mh)
+	}
+	return mhs
+}
+
+// notifyContent stores the multihashes of the given source as entries, as the
+// NotifyContent RPC does.
+func notifyContent(t *testing.T, d *Depute, source *testSource) *depute.Link {
+	t.Helper()
+	chunk, err := chunkSource(d, source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var l depute.Link
+	if err := l.Marshal(chunk); err != nil {
+		t.Fatal(err)
+	}
+	return &l
+}
+
+func collectGarbage(t *testing.T, d *Depute) *depute.CollectGarbage_Response {
+	t.Helper()
+	res, err := d.collectGarbage(context.Background(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func publishEntries(d *Depute, entries *depute.Link) error {
+	bitswap := metadata.Default.New(metadata.Bitswap{})
+	md, err := bitswap.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	_, err = d.Publish(context.Background(), &depute.Publish_Request{
+		Advertisement: &depute.Advertisement{
+			ContextId: []byte("fish"),
+			Metadata:  md,
+			Entries:   entries,
+		},
+	})
+	return err
+}
+
+func TestCollectGarbageKeepsStreamedEntries(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDepute(t, WithEntriesChunkSize(1), WithGCGracePeriod(0))
+	mhs := testMultihashes(t, 12)
+
+	// Collect garbage twice midway through the stream, so that the chunks
+	// stored so far would be swept if they were not tracked.
+	source := &testSource{
+		ctx: ctx,
+		mhs: mhs,
+		onRecv: func(i int) {
+			if i == len(mhs)/2 {
+				collectGarbage(t, d)
+				if res := collectGarbage(t, d); res.Swept != 0 {
+					t.Errorf("swept %d blocks of a running stream", res.Swept)
+				}
+			}
+		},
+	}
+	entries := notifyContent(t, d, source)
+
+	link, err := entries.Unmarshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var count int
+	if err := d.walkEntries(ctx, link, func(multihash.Multihash) error {
+		count++
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if count != len(mhs) {
+		t.Fatalf("expected %d entries; got %d", len(mhs), count)
+	}
+	if err := publishEntries(d, entries); err != nil {
+		t.Fatal(err)
+	}
+	if res := collectGarbage(t, d); res.Swept != 0 || res.Unreachable != 0 {
+		t.Fatalf("expected published entries to be reachable; got %d swept, %d unreachable", res.Swept, res.Unreachable)
+	}
+}
+
+func TestPublishSweptEntries(t *testing.T) {
+	d := newTestDepute(t, WithGCGracePeriod(0))
+	entries := notifyContent(t, d, &testSource{ctx: context.Background(), mhs: testMultihashes(t, 3)})
+
+	// Once the stream is done, unpublished entries are swept after the grace
+	// period.
+	collectGarbage(t, d)
+	if res := collectGarbage(t, d); res.Swept == 0 {
+		t.Fatal("expected unpublished entries to be swept")
+	}
+	err := publishEntries(d, entries)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition; got %v", err)
+	}
+}
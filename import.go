@@ -0,0 +1,193 @@
+package depute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gogo/status"
+	"github.com/ipfs/go-datastore"
+	carv2 "github.com/ipld/go-car/v2"
+	"github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	depute "github.com/ipni/depute/api/v0"
+	"github.com/ipni/go-libipni/ingest/schema"
+	"google.golang.org/grpc/codes"
+)
+
+func (d *Depute) Import(stream depute.Admin_ImportServer) error {
+	ctx := stream.Context()
+	f, err := os.CreateTemp("", "depute-import-*.car")
+	if err != nil {
+		logger.Errorw("Failed to create import file", "err", err)
+		return status.Errorf(codes.Internal, "failed to create import file: %v", err)
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+	var force, started bool
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if !started {
+			force = req.GetForce()
+			started = true
+		}
+		if _, err := f.Write(req.GetData()); err != nil {
+			return status.Errorf(codes.Internal, "failed to write import file: %v", err)
+		}
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return status.Errorf(codes.Internal, "failed to read import file: %v", err)
+	}
+
+	head, ads, blocks, err := d.importCar(ctx, f, force)
+	if err != nil {
+		if status.Code(err) != codes.Unknown {
+			return err
+		}
+		logger.Errorw("Failed to import CAR", "err", err)
+		return status.Errorf(codes.Internal, "failed to import CAR: %v", err)
+	}
+	var l depute.Link
+	if err := l.Marshal(head); err != nil {
+		return status.Errorf(codes.Internal, "failed to marshal link: %v", err)
+	}
+	return stream.SendAndClose(&depute.Import_Response{
+		Link:           &l,
+		Advertisements: ads,
+		Blocks:         blocks,
+	})
+}
+
+// importCar stores the blocks of the CAR read from r, and sets the CAR root as
+// the advertisement chain head once the chain from it is verified to be
+// complete and signed by the host identity. The state of context IDs is
+// rebuilt from the chain.
+//
+// Unless forced, the imported chain must extend the current chain head, if
+// any. If forced, the chain may also lack the advertisements older than some
+// advertisement, as exported with a stop point, in which case the state of
+// context IDs is rebuilt from the advertisements imported alone. A chain that
+// misses an advertisement in its middle cannot be told apart from such a
+// partial chain, so partial chains are only imported when forced.
+func (d *Depute) importCar(ctx context.Context, r io.Reader, force bool) (ipld.Link, uint64, uint64, error) {
+	br, err := carv2.NewBlockReader(r)
+	if err != nil {
+		return nil, 0, 0, status.Errorf(codes.InvalidArgument, "invalid CAR: %v", err)
+	}
+	if len(br.Roots) != 1 {
+		return nil, 0, 0, status.Errorf(codes.InvalidArgument, "CAR must have exactly one root; got %d", len(br.Roots))
+	}
+	root := cidlink.Link{Cid: br.Roots[0]}
+
+	// Blocks are stored before the chain is verified. They are tracked so
+	// that garbage collection does not sweep them until the chain head is
+	// set. Should verification fail, they are left for garbage collection.
+	ls, untrack := d.trackEntries(*d.ls)
+	defer untrack()
+	var blocks uint64
+	var rootFound bool
+	for {
+		b, err := br.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, 0, 0, status.Errorf(codes.InvalidArgument, "invalid CAR: %v", err)
+		}
+		c := b.Cid()
+		if sum, err := c.Prefix().Sum(b.RawData()); err != nil || !sum.Equals(c) {
+			return nil, 0, 0, status.Errorf(codes.InvalidArgument, "block %s does not match its CID", c)
+		}
+		w, commit, err := ls.StorageWriteOpener(ipld.LinkContext{Ctx: ctx})
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		if _, err := w.Write(b.RawData()); err != nil {
+			return nil, 0, 0, err
+		}
+		if err := commit(cidlink.Link{Cid: c}); err != nil {
+			return nil, 0, 0, fmt.Errorf("cannot store block %s: %w", c, err)
+		}
+		blocks++
+		rootFound = rootFound || c.Equals(root.Cid)
+	}
+	if !rootFound {
+		return nil, 0, 0, status.Errorf(codes.InvalidArgument, "CAR does not contain its root %s", root)
+	}
+
+	d.headLock.Lock()
+	defer d.headLock.Unlock()
+	previous, err := d.getLatestAdvertisementLink(ctx)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("cannot get latest advertisement link: %w", err)
+	}
+	extends := previous == nil
+	type chainAd struct {
+		link ipld.Link
+		ad   *schema.Advertisement
+	}
+	var chain []chainAd
+	var truncated bool
+	err = d.walkAdvertisements(ctx, root, func(l ipld.Link, ad *schema.Advertisement) (bool, error) {
+		if linkCid(l) == linkCid(previous) {
+			extends = true
+		}
+		signer, err := ad.VerifySignature()
+		if err != nil {
+			return false, status.Errorf(codes.InvalidArgument, "invalid signature of advertisement %s: %v", l, err)
+		}
+		if signer != d.h.ID() {
+			return false, status.Errorf(codes.InvalidArgument, "advertisement %s is signed by %s instead of %s", l, signer, d.h.ID())
+		}
+		chain = append(chain, chainAd{l, ad})
+		return true, nil
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, datastore.ErrNotFound):
+		// The root is stored, so the advertisement missing is the previous of
+		// the oldest one walked.
+		if len(chain) == 0 || !force {
+			return nil, 0, 0, status.Errorf(codes.InvalidArgument, "incomplete advertisement chain: %v; force to import a partial chain", err)
+		}
+		truncated = true
+	default:
+		return nil, 0, 0, err
+	}
+	if !extends && !force {
+		return nil, 0, 0, status.Errorf(codes.FailedPrecondition, "imported chain does not extend latest ad link %s; force to replace it", linkString(previous))
+	}
+
+	// Replay the chain from its start to rebuild the state of context IDs.
+	byContext := make(map[string]*depute.ContextState)
+	for i := len(chain) - 1; i >= 0; i-- {
+		c := chain[i]
+		s, err := nextContextState(byContext[string(c.ad.ContextID)], c.link, c.ad)
+		if err != nil {
+			return nil, 0, 0, fmt.Errorf("cannot create context state: %w", err)
+		}
+		byContext[string(c.ad.ContextID)] = s
+	}
+	states := make([]*depute.ContextState, 0, len(byContext))
+	for _, s := range byContext {
+		states = append(states, s)
+	}
+	if err := d.updateHead(ctx, previous, root, true, states); err != nil {
+		return nil, 0, 0, fmt.Errorf("cannot set latest advertisement link: %w", err)
+	}
+	if truncated {
+		logger.Warnw("Imported partial advertisement chain", "oldest", chain[len(chain)-1].link.String(), "missing", chain[len(chain)-1].ad.PreviousID.String())
+	}
+	logger.Infow("Imported CAR", "head", root.String(), "previous", linkString(previous), "ads", len(chain), "blocks", blocks)
+	return root, uint64(len(chain)), blocks, nil
+}
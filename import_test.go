@@ -0,0 +1,179 @@
+package depute
+
+import (
+	"context"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gogo/status"
+	"github.com/ipld/go-ipld-prime"
+	depute "github.com/ipni/depute/api/v0"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"google.golang.org/grpc/codes"
+)
+
+// newTestIdentityDepute returns a depute whose host has the given identity.
+func newTestIdentityDepute(t *testing.T, key crypto.PrivKey) *Depute {
+	t.Helper()
+	h, err := libp2p.New(libp2p.Identity(key), libp2p.NoListenAddrs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newTestDepute(t, WithHost(h))
+}
+
+func newTestKey(t *testing.T) crypto.PrivKey {
+	t.Helper()
+	key, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// publishTestChain publishes an advertisement for each of the given context
+// IDs, and returns the links to them in the order published.
+func publishTestChain(t *testing.T, d *Depute, contextIDs ...string) []*depute.Link {
+	t.Helper()
+	ctx := context.Background()
+	entries := notifyContent(t, d, &testSource{ctx: ctx, mhs: testMultihashes(t, 3)})
+	links := make([]*depute.Link, 0, len(contextIDs))
+	for _, id := range contextIDs {
+		resp, err := d.Publish(ctx, &depute.Publish_Request{
+			Advertisement: &depute.Advertisement{
+				ContextId: []byte(id),
+				Metadata:  testMetadata(t),
+				Entries:   entries,
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		links = append(links, resp.GetLink())
+	}
+	return links
+}
+
+// exportTestCar exports the chain of the given depute up to stopAt, if
+// non-nil, and returns the path to the CAR.
+func exportTestCar(t *testing.T, d *Depute, stopAt *depute.Link) string {
+	t.Helper()
+	ctx := context.Background()
+	head, err := d.getLatestAdvertisementLink(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stop ipld.Link
+	if stopAt != nil {
+		if stop, err = stopAt.Unmarshal(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f, err := os.Create(filepath.Join(t.TempDir(), "depute.car"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := d.exportCar(ctx, f, head, stop, false); err != nil {
+		t.Fatal(err)
+	}
+	return f.Name()
+}
+
+func importTestCar(t *testing.T, d *Depute, path string, force bool) (ipld.Link, uint64, error) {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	head, ads, _, err := d.importCar(context.Background(), f, force)
+	return head, ads, err
+}
+
+func TestImportCar(t *testing.T) {
+	ctx := context.Background()
+	key := newTestKey(t)
+	source := newTestIdentityDepute(t, key)
+	links := publishTestChain(t, source, "fish", "lobster", "crab")
+	car := exportTestCar(t, source, nil)
+
+	d := newTestIdentityDepute(t, key)
+	head, ads, err := importTestCar(t, d, car, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := links[2].Unmarshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ads != 3 || head != want {
+		t.Fatalf("expected 3 advertisements imported up to %s; got %d up to %s", want, ads, head)
+	}
+	if got := chainLinks(t, d); len(got) != 3 {
+		t.Fatalf("expected a chain of 3 advertisements; got %d", len(got))
+	}
+	for _, id := range []string{"fish", "lobster", "crab"} {
+		state, err := d.getContextState(ctx, []byte(id))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if state == nil || state.Removed {
+			t.Fatalf("expected context ID %s to be live; got %v", id, state)
+		}
+	}
+}
+
+func TestImportCarVerifiesChain(t *testing.T) {
+	key := newTestKey(t)
+	source := newTestIdentityDepute(t, key)
+	links := publishTestChain(t, source, "fish", "lobster", "crab")
+	car := exportTestCar(t, source, nil)
+	// The partial chain lacks the oldest advertisement.
+	partial := exportTestCar(t, source, links[0])
+
+	t.Run("signed by another identity", func(t *testing.T) {
+		d := newTestIdentityDepute(t, newTestKey(t))
+		if _, _, err := importTestCar(t, d, car, true); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument; got %v", err)
+		}
+	})
+
+	t.Run("partial chain", func(t *testing.T) {
+		d := newTestIdentityDepute(t, key)
+		if _, _, err := importTestCar(t, d, partial, false); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("expected InvalidArgument unless forced; got %v", err)
+		}
+		if head, err := d.getLatestAdvertisementLink(context.Background()); err != nil || head != nil {
+			t.Fatalf("expected no chain head; got %v, %v", head, err)
+		}
+		_, ads, err := importTestCar(t, d, partial, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ads != 2 {
+			t.Fatalf("expected 2 advertisements imported; got %d", ads)
+		}
+	})
+
+	t.Run("does not extend head", func(t *testing.T) {
+		d := newTestIdentityDepute(t, key)
+		publishTestChain(t, d, "octopus")
+		if _, _, err := importTestCar(t, d, car, false); status.Code(err) != codes.FailedPrecondition {
+			t.Fatalf("expected FailedPrecondition unless forced; got %v", err)
+		}
+		if _, _, err := importTestCar(t, d, car, true); err != nil {
+			t.Fatal(err)
+		}
+		state, err := d.getContextState(context.Background(), []byte("octopus"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if state != nil {
+			t.Fatalf("expected state of replaced chain to be removed; got %v", state)
+		}
+	})
+}
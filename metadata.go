@@ -0,0 +1,52 @@
+package depute
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ipfs/go-cid"
+	depute "github.com/ipni/depute/api/v0"
+	"github.com/ipni/go-libipni/metadata"
+)
+
+// advertisementMetadata returns the metadata bytes of the given advertisement,
+// encoding its typed metadata if set.
+func advertisementMetadata(ad *depute.Advertisement) ([]byte, error) {
+	if ad.GetTypedMetadata() == nil {
+		return ad.GetMetadata(), nil
+	}
+	if ad.Metadata != nil {
+		return nil, errors.New("metadata and typed metadata are mutually exclusive")
+	}
+	return encodeMetadata(ad.GetTypedMetadata())
+}
+
+// encodeMetadata encodes the given typed metadata as go-libipni metadata.
+func encodeMetadata(md *depute.Metadata) ([]byte, error) {
+	if len(md.GetProtocols()) == 0 {
+		return nil, errors.New("at least one protocol must be specified")
+	}
+	protocols := make([]metadata.Protocol, 0, len(md.GetProtocols()))
+	for i, p := range md.GetProtocols() {
+		switch p := p.GetProtocol().(type) {
+		case *depute.Metadata_Protocol_Bitswap:
+			protocols = append(protocols, &metadata.Bitswap{})
+		case *depute.Metadata_Protocol_GraphsyncFilecoinv1:
+			pieceCid, err := cid.Cast(p.GraphsyncFilecoinv1.GetPieceCid())
+			if err != nil {
+				return nil, fmt.Errorf("invalid piece CID of protocol %d: %w", i, err)
+			}
+			protocols = append(protocols, &metadata.GraphsyncFilecoinV1{
+				PieceCID:      pieceCid,
+				VerifiedDeal:  p.GraphsyncFilecoinv1.GetVerifiedDeal(),
+				FastRetrieval: p.GraphsyncFilecoinv1.GetFastRetrieval(),
+			})
+		case *depute.Metadata_Protocol_IpfsGatewayHttp:
+			protocols = append(protocols, &metadata.IpfsGatewayHttp{})
+		default:
+			return nil, fmt.Errorf("protocol %d is not specified", i)
+		}
+	}
+	encoded := metadata.Default.New(protocols...)
+	return encoded.MarshalBinary()
+}
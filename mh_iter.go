@@ -1,21 +1,138 @@
 package depute
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync/atomic"
+
+	"github.com/gogo/status"
 	depute "github.com/ipni/depute/api/v0"
 	provider "github.com/ipni/index-provider"
+	"github.com/multiformats/go-multicodec"
 	"github.com/multiformats/go-multihash"
+	"google.golang.org/grpc/codes"
 )
 
 var _ provider.MultihashIterator = (*notifyContentIter)(nil)
 
+// notifyContentSource is the receiving side of NotifyContent streams.
+type notifyContentSource interface {
+	Recv() (*depute.NotifyContent_Request, error)
+	Context() context.Context
+}
+
+// advertiseSource receives the multihashes that follow the header of an
+// Advertise stream.
+type advertiseSource struct {
+	depute.Publisher_AdvertiseServer
+}
+
+func (s advertiseSource) Recv() (*depute.NotifyContent_Request, error) {
+	req, err := s.Publisher_AdvertiseServer.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if req.GetHeader() != nil {
+		return nil, status.Error(codes.InvalidArgument, "header must only be sent in the first request")
+	}
+	return &depute.NotifyContent_Request{Multihash: req.GetMultihash()}, nil
+}
+
 type notifyContentIter struct {
-	source depute.Publisher_NotifyContentServer
+	source notifyContentSource
+	// allowedCodes is the set of multihash codes accepted. All codes are
+	// accepted if empty.
+	allowedCodes map[multicodec.Code]struct{}
+
+	options *depute.NotifyContent_Options
+	// pending is the first request, if it carries a multihash.
+	pending *depute.NotifyContent_Request
+	// seen is the set of multihashes received when de-duplicating.
+	seen *mhSet
+	// received is the number of multihashes received, and the zero-based
+	// index of the next one. Counters may be read while the iterator is in
+	// use.
+	received   atomic.Uint64
+	skipped    atomic.Uint64
+	duplicates atomic.Uint64
 }
 
-func (i *notifyContentIter) Next() (multihash.Multihash, error) {
+// start receives the first request of the stream to read its options. It
+// must be called before Next.
+func (i *notifyContentIter) start() error {
 	req, err := i.source.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	i.options = req.GetOptions()
+	if i.options == nil {
+		i.options = &depute.NotifyContent_Options{}
+	}
+	// The first request may carry options alone.
+	if req != nil && (req.Multihash != nil || req.Options == nil) {
+		i.pending = req
+	}
+	return nil
+}
+
+func (i *notifyContentIter) Next() (multihash.Multihash, error) {
+	for {
+		req := i.pending
+		if req != nil {
+			i.pending = nil
+		} else {
+			var err error
+			req, err = i.source.Recv()
+			if err != nil {
+				return nil, err
+			}
+		}
+		index := i.received.Add(1) - 1
+		mh, err := i.check(req.GetMultihash().GetValue())
+		if err != nil {
+			if !i.options.GetSkipInvalid() {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid multihash at index %d: %v", index, err)
+			}
+			i.skipped.Add(1)
+			logger.Debugw("Skipped invalid multihash", "index", index, "err", err)
+			continue
+		}
+		if i.seen != nil {
+			added, err := i.seen.add(i.source.Context(), mh)
+			if err != nil {
+				return nil, fmt.Errorf("failed to de-duplicate multihash: %w", err)
+			}
+			if !added {
+				i.duplicates.Add(1)
+				continue
+			}
+		}
+		return mh, nil
+	}
+}
+
+// close releases the resources held by the iterator.
+func (i *notifyContentIter) close() {
+	if i.seen == nil {
+		return
+	}
+	if err := i.seen.close(context.Background()); err != nil {
+		logger.Warnw("Failed to release multihash set", "err", err)
+	}
+}
+
+// check decodes the given multihash and checks that its code is allowed.
+func (i *notifyContentIter) check(value []byte) (multihash.Multihash, error) {
+	dmh, err := multihash.Decode(value)
 	if err != nil {
 		return nil, err
 	}
-	return req.Multihash.GetValue(), err
+	if len(i.allowedCodes) != 0 {
+		if _, ok := i.allowedCodes[multicodec.Code(dmh.Code)]; !ok {
+			return nil, fmt.Errorf("multihash code %s is not allowed", multicodec.Code(dmh.Code))
+		}
+	}
+	return value, nil
 }
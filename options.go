@@ -1,8 +1,11 @@
 package depute
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net/url"
+	"time"
 
 	"github.com/ipfs/go-datastore"
 	"github.com/ipfs/go-datastore/namespace"
@@ -12,37 +15,87 @@ import (
 	"github.com/ipld/go-ipld-prime/storage/dsadapter"
 	"github.com/ipni/go-libipni/dagsync"
 	"github.com/ipni/go-libipni/dagsync/ipnisync"
+	"github.com/ipni/index-provider/engine/chunker"
 	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/multiformats/go-multiaddr"
+	"github.com/multiformats/go-multicodec"
 	"google.golang.org/grpc"
 )
 
-const DefaultTopic = "/indexer/ingest/mainnet"
+const (
+	DefaultTopic = "/indexer/ingest/mainnet"
+	// DefaultEntriesChunkSize is the default maximum number of multihashes
+	// per entries chain chunk.
+	DefaultEntriesChunkSize = 16384
+	// DefaultDedupMemoryLimit is the default maximum number of multihashes
+	// held in memory by each de-duplicating NotifyContent stream.
+	DefaultDedupMemoryLimit = 1 << 16
+	// DefaultProgressInterval is the default interval at which the progress
+	// of NotifyContentStream is reported.
+	DefaultProgressInterval = 5 * time.Second
+	// DefaultSessionExpiry is the default time after its last update at which
+	// a resumable upload session is removed.
+	DefaultSessionExpiry = 24 * time.Hour
+	// DefaultGCGracePeriod is the default time for which a block must have
+	// been found unreachable before garbage collection deletes it.
+	DefaultGCGracePeriod = time.Hour
+	// DefaultAnnounceRetryMinBackoff is the default delay before the first
+	// retry of a failed HTTP announcement.
+	DefaultAnnounceRetryMinBackoff = 5 * time.Second
+	// DefaultAnnounceRetryMaxBackoff is the default maximum delay between
+	// retries of a failed HTTP announcement.
+	DefaultAnnounceRetryMaxBackoff = 10 * time.Minute
+)
 
 type (
 	Option  func(*options) error
 	options struct {
+		chunker            chunker.NewChunkerFunc
 		directAnnounceURLs []*url.URL
 		httpListenAddr     string
 		noPubsubAnnounce   bool
 		publishAddrs       []multiaddr.Multiaddr
 
-		ds             datastore.Batching
-		grpcListenAddr string
-		grpcServerOpts []grpc.ServerOption
-		h              host.Host
-		ls             *ipld.LinkSystem
-		retrievalAddrs []string
-		publisher      dagsync.Publisher
-		pubTopicName   string
+		dataDir          string
+		dedupMemLimit    int
+		ds               datastore.Batching
+		dsCloser         io.Closer
+		grpcListenAddr   string
+		grpcServerOpts   []grpc.ServerOption
+		h                host.Host
+		ls               *ipld.LinkSystem
+		mhCodes          map[multicodec.Code]struct{}
+		progressInterval time.Duration
+		// sessionChunkSize is the entries chunk size of resumable uploads,
+		// which are only supported for chained entries if non-zero.
+		sessionChunkSize        int
+		sessionExpiry           time.Duration
+		gcInterval              time.Duration
+		gcGracePeriod           time.Duration
+		reannounceInterval      time.Duration
+		announceWindow          time.Duration
+		announceRetryMinBackoff time.Duration
+		announceRetryMaxBackoff time.Duration
+		retrievalAddrs          []string
+		publisher               dagsync.Publisher
+		pubTopicName            string
+		signer                  Signer
 	}
 )
 
-func newOptions(o ...Option) (*options, error) {
+func newOptions(o ...Option) (_ *options, err error) {
 	opts := options{
-		grpcListenAddr: "0.0.0.0:40080",
-		pubTopicName:   DefaultTopic,
+		chunker:                 chunker.NewChainChunkerFunc(DefaultEntriesChunkSize),
+		dedupMemLimit:           DefaultDedupMemoryLimit,
+		progressInterval:        DefaultProgressInterval,
+		sessionChunkSize:        DefaultEntriesChunkSize,
+		sessionExpiry:           DefaultSessionExpiry,
+		gcGracePeriod:           DefaultGCGracePeriod,
+		announceRetryMinBackoff: DefaultAnnounceRetryMinBackoff,
+		announceRetryMaxBackoff: DefaultAnnounceRetryMaxBackoff,
+		grpcListenAddr:          "0.0.0.0:40080",
+		pubTopicName:            DefaultTopic,
 	}
 	for _, apply := range o {
 		if err := apply(&opts); err != nil {
@@ -50,7 +103,6 @@ func newOptions(o ...Option) (*options, error) {
 		}
 	}
 
-	var err error
 	if opts.h == nil {
 		opts.h, err = libp2p.New()
 		if err != nil {
@@ -58,7 +110,20 @@ func newOptions(o ...Option) (*options, error) {
 		}
 	}
 	if opts.ds == nil {
-		opts.ds = sync.MutexWrap(datastore.NewMapDatastore())
+		if opts.dataDir != "" {
+			opts.ds, opts.dsCloser, err = openDataDir(opts.dataDir)
+			if err != nil {
+				return nil, err
+			}
+			defer func() {
+				if err != nil {
+					_ = opts.closeDataDir()
+				}
+			}()
+			logger.Infow("Using persistent datastore", "dataDir", opts.dataDir)
+		} else {
+			opts.ds = sync.MutexWrap(datastore.NewMapDatastore())
+		}
 	}
 	if opts.ls == nil {
 		ls := cidlink.DefaultLinkSystem()
@@ -77,8 +142,17 @@ func newOptions(o ...Option) (*options, error) {
 		}
 	}
 
+	privKey := opts.h.Peerstore().PrivKey(opts.h.ID())
+	if opts.signer == nil {
+		if privKey == nil {
+			return nil, errors.New("no private key for host identity; a signer must be specified")
+		}
+		opts.signer = PrivKeySigner(privKey)
+	}
 	if opts.publisher == nil {
-		privKey := opts.h.Peerstore().PrivKey(opts.h.ID())
+		if privKey == nil {
+			return nil, errors.New("no private key for host identity; a publisher must be specified when using a signer")
+		}
 		opts.publisher, err = ipnisync.NewPublisher(*opts.ls, privKey,
 			ipnisync.WithStreamHost(opts.h),
 			ipnisync.WithHeadTopic(opts.pubTopicName),
@@ -101,6 +175,203 @@ func newOptions(o ...Option) (*options, error) {
 	return &opts, nil
 }
 
+// WithChunker sets the function used to instantiate the chunker that stores
+// the multihashes received by NotifyContent as advertisement entries.
+// Resumable uploads are not supported with a custom chunker.
+func WithChunker(f chunker.NewChunkerFunc) Option {
+	return func(o *options) error {
+		o.chunker = f
+		o.sessionChunkSize = 0
+		return nil
+	}
+}
+
+// WithEntriesChunkSize sets the format of advertisement entries to a chain of
+// entry chunks, each containing at most the given number of multihashes.
+//
+// If no chunker is set, entries are chained with at most
+// DefaultEntriesChunkSize multihashes per chunk.
+func WithEntriesChunkSize(size int) Option {
+	return func(o *options) error {
+		if size < 1 {
+			return fmt.Errorf("entries chunk size must be at least 1; got: %d", size)
+		}
+		o.chunker = chunker.NewChainChunkerFunc(size)
+		o.sessionChunkSize = size
+		return nil
+	}
+}
+
+// WithHamtEntries sets the format of advertisement entries to an IPLD HAMT
+// with the given hash algorithm, bit-width and bucket size, which suits very
+// large sets of multihashes. Resumable uploads are not supported with HAMT
+// entries.
+func WithHamtEntries(hashAlg multicodec.Code, bitWidth, bucketSize int) Option {
+	return func(o *options) error {
+		o.chunker = chunker.NewHamtChunkerFunc(hashAlg, bitWidth, bucketSize)
+		o.sessionChunkSize = 0
+		return nil
+	}
+}
+
+// WithMultihashCodes restricts the multihashes accepted by NotifyContent to
+// the given codes. All valid multihashes are accepted if unset.
+func WithMultihashCodes(codes ...multicodec.Code) Option {
+	return func(o *options) error {
+		if len(codes) == 0 {
+			o.mhCodes = nil
+			return nil
+		}
+		o.mhCodes = make(map[multicodec.Code]struct{}, len(codes))
+		for _, code := range codes {
+			o.mhCodes[code] = struct{}{}
+		}
+		return nil
+	}
+}
+
+// WithDedupMemoryLimit sets the maximum number of multihashes held in memory
+// by each de-duplicating NotifyContent stream, beyond which the multihashes
+// seen are spilled to the datastore.
+//
+// If unset, DefaultDedupMemoryLimit is used.
+func WithDedupMemoryLimit(limit int) Option {
+	return func(o *options) error {
+		if limit < 1 {
+			return fmt.Errorf("dedup memory limit must be at least 1; got: %d", limit)
+		}
+		o.dedupMemLimit = limit
+		return nil
+	}
+}
+
+// WithProgressInterval sets the interval at which the progress of
+// NotifyContentStream is reported to clients.
+//
+// If unset, DefaultProgressInterval is used.
+func WithProgressInterval(interval time.Duration) Option {
+	return func(o *options) error {
+		if interval <= 0 {
+			return fmt.Errorf("progress interval must be positive; got: %s", interval)
+		}
+		o.progressInterval = interval
+		return nil
+	}
+}
+
+// WithSessionExpiry sets the time after its last update at which a resumable
+// upload session is removed, whether complete or not.
+//
+// If unset, DefaultSessionExpiry is used.
+func WithSessionExpiry(expiry time.Duration) Option {
+	return func(o *options) error {
+		if expiry <= 0 {
+			return fmt.Errorf("session expiry must be positive; got: %s", expiry)
+		}
+		o.sessionExpiry = expiry
+		return nil
+	}
+}
+
+// WithGCInterval sets the interval at which garbage collection deletes the
+// blocks that are no longer reachable from the advertisement chain, such as
+// entries that were never published. Garbage collection is only run on demand
+// if unset.
+func WithGCInterval(interval time.Duration) Option {
+	return func(o *options) error {
+		if interval < 0 {
+			return fmt.Errorf("gc interval must not be negative; got: %s", interval)
+		}
+		o.gcInterval = interval
+		return nil
+	}
+}
+
+// WithGCGracePeriod sets the time for which a block must have been found
+// unreachable by garbage collection before it is deleted. Entries must be
+// published within the grace period once the stream that stores them is done.
+//
+// If unset, DefaultGCGracePeriod is used.
+func WithGCGracePeriod(period time.Duration) Option {
+	return func(o *options) error {
+		if period < 0 {
+			return fmt.Errorf("gc grace period must not be negative; got: %s", period)
+		}
+		o.gcGracePeriod = period
+		return nil
+	}
+}
+
+// WithReannounceInterval sets the interval at which the advertisement chain
+// head is announced again through all the configured announcement senders,
+// so that indexers that missed an announcement eventually learn about the
+// head. The head is only announced when published if unset.
+func WithReannounceInterval(interval time.Duration) Option {
+	return func(o *options) error {
+		if interval < 0 {
+			return fmt.Errorf("reannounce interval must not be negative; got: %s", interval)
+		}
+		o.reannounceInterval = interval
+		return nil
+	}
+}
+
+// WithAnnounceWindow sets the time for which announcements are held back once
+// an advertisement is published, after which only the newest chain head is
+// announced. This coalesces the announcements of bursts of publications.
+// Advertisements are announced as they are published if unset.
+func WithAnnounceWindow(window time.Duration) Option {
+	return func(o *options) error {
+		if window < 0 {
+			return fmt.Errorf("announce window must not be negative; got: %s", window)
+		}
+		o.announceWindow = window
+		return nil
+	}
+}
+
+// WithAnnounceRetryBackoff sets the delay before the first retry of a failed
+// HTTP announcement, and the maximum delay between retries. The delay doubles
+// after each failed retry.
+//
+// If unset, DefaultAnnounceRetryMinBackoff and DefaultAnnounceRetryMaxBackoff
+// are used.
+func WithAnnounceRetryBackoff(min, max time.Duration) Option {
+	return func(o *options) error {
+		if min <= 0 {
+			return fmt.Errorf("announce retry backoff must be positive; got: %s", min)
+		}
+		if max < min {
+			return fmt.Errorf("maximum announce retry backoff must be at least %s; got: %s", min, max)
+		}
+		o.announceRetryMinBackoff = min
+		o.announceRetryMaxBackoff = max
+		return nil
+	}
+}
+
+// WithDatastore sets the datastore in which the advertisement chain, entries
+// and depute state are stored. The datastore is closed on Shutdown.
+//
+// If unset, a datastore is opened in the data directory specified by
+// WithDataDir, or an in-memory datastore is used.
+func WithDatastore(ds datastore.Batching) Option {
+	return func(o *options) error {
+		o.ds = ds
+		return nil
+	}
+}
+
+// WithDataDir sets the directory in which a persistent datastore is opened.
+// The directory is locked while in use, and is created if it does not exist.
+// Ignored if a datastore is set via WithDatastore.
+func WithDataDir(dir string) Option {
+	return func(o *options) error {
+		o.dataDir = dir
+		return nil
+	}
+}
+
 // WithDirectAnnounceURLs sets URLs of indexers to send direct HTTP
 // announcements to.
 func WithDirectAnnounceURLs(urls []string) Option {
@@ -162,6 +433,19 @@ func WithPublisher(publisher dagsync.Publisher) Option {
 	}
 }
 
+// WithSigner sets the Signer used to sign advertisements. If unset, the
+// advertisements are signed with the private key of the libp2p host identity.
+//
+// The default publisher signs head requests with the private key of the host
+// identity. If the host peerstore does not hold that key, a publisher must
+// also be set via WithPublisher.
+func WithSigner(s Signer) Option {
+	return func(o *options) error {
+		o.signer = s
+		return nil
+	}
+}
+
 // WithPublishTopic sets the topic that pubsub messages are send on.
 func WithPublishTopic(topicName string) Option {
 	return func(o *options) error {
@@ -0,0 +1,71 @@
+package depute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/status"
+	"github.com/ipld/go-ipld-prime"
+	depute "github.com/ipni/depute/api/v0"
+	"google.golang.org/grpc/codes"
+)
+
+func (d *Depute) Reannounce(ctx context.Context, _ *depute.Reannounce_Request) (*depute.Reannounce_Response, error) {
+	head, err := d.reannounce(ctx)
+	if err != nil {
+		if status.Code(err) != codes.Unknown {
+			return nil, err
+		}
+		logger.Errorw("Failed to reannounce head", "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to reannounce head: %v", err)
+	}
+	var l depute.Link
+	if err := l.Marshal(head); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal link: %v", err)
+	}
+	return &depute.Reannounce_Response{
+		Link: &l,
+	}, nil
+}
+
+// reannounceLoop announces the advertisement chain head at the configured
+// interval until Shutdown.
+func (d *Depute) reannounceLoop() {
+	defer d.wg.Done()
+	ticker := time.NewTicker(d.reannounceInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.closing:
+			return
+		case <-ticker.C:
+			ctx, cancel := d.closingContext()
+			if _, err := d.reannounce(ctx); err != nil && status.Code(err) != codes.FailedPrecondition {
+				logger.Errorw("Failed to reannounce head", "err", err)
+			}
+			cancel()
+		}
+	}
+}
+
+// reannounce announces the current advertisement chain head through all the
+// announcement senders, and returns the head. Failed HTTP deliveries are
+// retried in the background.
+func (d *Depute) reannounce(ctx context.Context) (ipld.Link, error) {
+	if len(d.announcers) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "no announcement senders are configured")
+	}
+	head, seq, err := d.currentHead(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get latest advertisement link: %w", err)
+	}
+	if head == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no advertisement to announce")
+	}
+	if err := d.announce(ctx, linkCid(head), seq); err != nil {
+		return nil, fmt.Errorf("cannot announce %s: %w", head, err)
+	}
+	logger.Infow("Reannounced advertisement", "link", head.String())
+	return head, nil
+}
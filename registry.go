@@ -0,0 +1,127 @@
+package depute
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/ipld/go-ipld-prime"
+	depute "github.com/ipni/depute/api/v0"
+	"github.com/ipni/go-libipni/ingest/schema"
+	"google.golang.org/protobuf/proto"
+)
+
+// dsKeyPrefixContext is the datastore key prefix under which the state of
+// each advertised context ID is stored, keyed by the hex encoded context ID.
+// Hex encoding preserves the byte order of context IDs, so that contexts are
+// listed in context ID order.
+var dsKeyPrefixContext = datastore.NewKey("depute/context")
+
+func contextKey(contextID []byte) datastore.Key {
+	return dsKeyPrefixContext.ChildString(hex.EncodeToString(contextID))
+}
+
+// getContextState returns the state of the given context ID, or nil if the
+// context ID has never been advertised.
+func (d *Depute) getContextState(ctx context.Context, contextID []byte) (*depute.ContextState, error) {
+	if len(contextID) == 0 {
+		return nil, nil
+	}
+	v, err := d.ds.Get(ctx, contextKey(contextID))
+	switch err {
+	case nil:
+		var s depute.ContextState
+		if err := proto.Unmarshal(v, &s); err != nil {
+			return nil, fmt.Errorf("cannot decode context state: %w", err)
+		}
+		return &s, nil
+	case datastore.ErrNotFound:
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
+func putContextState(ctx context.Context, w datastore.Write, s *depute.ContextState) error {
+	v, err := proto.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("cannot encode context state: %w", err)
+	}
+	return w.Put(ctx, contextKey(s.ContextId), v)
+}
+
+// listContextStates calls f for each context ID that starts with the given
+// prefix in context ID byte order, until f returns false or an error.
+//
+// Datastore prefix queries match whole key path segments, so the context IDs
+// with the prefix are found by scanning the states in order from the first
+// context ID, which costs a read of every state up to the last match.
+func (d *Depute) listContextStates(ctx context.Context, prefix []byte, f func(*depute.ContextState) (bool, error)) error {
+	results, err := d.ds.Query(ctx, query.Query{
+		Prefix: dsKeyPrefixContext.String(),
+		Orders: []query.Order{query.OrderByKey{}},
+	})
+	if err != nil {
+		return err
+	}
+	defer results.Close()
+	hexPrefix := hex.EncodeToString(prefix)
+	for r := range results.Next() {
+		if r.Error != nil {
+			return r.Error
+		}
+		if k := datastore.RawKey(r.Key).BaseNamespace(); !strings.HasPrefix(k, hexPrefix) {
+			if k > hexPrefix {
+				// Past the context IDs with the prefix.
+				return nil
+			}
+			continue
+		}
+		var s depute.ContextState
+		if err := proto.Unmarshal(r.Value, &s); err != nil {
+			return fmt.Errorf("cannot decode context state: %w", err)
+		}
+		if more, err := f(&s); err != nil || !more {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextContextState returns the state of the advertisement context ID after the
+// given advertisement with the given link is published, where previous is the
+// state prior to that, if any. An advertisement with no entries that is not a
+// removal only updates metadata, so the entries of a live context are kept.
+func nextContextState(previous *depute.ContextState, l ipld.Link, ad *schema.Advertisement) (*depute.ContextState, error) {
+	s := &depute.ContextState{
+		ContextId:     ad.ContextID,
+		Advertisement: &depute.Link{},
+		Metadata:      ad.Metadata,
+		Removed:       ad.IsRm,
+	}
+	if err := s.Advertisement.Marshal(l); err != nil {
+		return nil, err
+	}
+	switch {
+	case ad.Entries != nil && ad.Entries != schema.NoEntries:
+		s.Entries = &depute.Link{}
+		if err := s.Entries.Marshal(ad.Entries); err != nil {
+			return nil, err
+		}
+	case !ad.IsRm && previous != nil && !previous.Removed:
+		s.Entries = previous.Entries
+	}
+	return s, nil
+}
+
+// contextStatus describes why the context ID with the given state is not
+// live.
+func contextStatus(state *depute.ContextState) string {
+	if state == nil {
+		return "has not been advertised"
+	}
+	return "has been removed"
+}
@@ -0,0 +1,276 @@
+package depute
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gogo/status"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/ipld/go-ipld-prime"
+	depute "github.com/ipni/depute/api/v0"
+	"github.com/ipni/go-libipni/ingest/schema"
+	"github.com/multiformats/go-multihash"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// dsKeyPrefixSession is the datastore key prefix under which the state of
+	// resumable upload sessions is stored, keyed by the hex encoded session ID.
+	dsKeyPrefixSession = datastore.NewKey("depute/session")
+	// dsKeyPrefixSessionDedup is the datastore key prefix under which the
+	// multihashes seen by de-duplicating sessions are stored, keyed by the hex
+	// encoded session ID and multihash.
+	dsKeyPrefixSessionDedup = datastore.NewKey("depute/sessiondedup")
+)
+
+func sessionKey(id string) datastore.Key {
+	return dsKeyPrefixSession.ChildString(hex.EncodeToString([]byte(id)))
+}
+
+func sessionDedupPrefix(id string) datastore.Key {
+	return dsKeyPrefixSessionDedup.ChildString(hex.EncodeToString([]byte(id)))
+}
+
+func (d *Depute) ResumeContent(ctx context.Context, req *depute.ResumeContent_Request) (*depute.ResumeContent_Response, error) {
+	if req.GetSessionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no session ID")
+	}
+	s, err := d.getContentSession(ctx, req.GetSessionId())
+	if err != nil {
+		logger.Errorw("Failed to get content session", "id", req.GetSessionId(), "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to get content session: %v", err)
+	}
+	if s == nil {
+		return nil, status.Errorf(codes.NotFound, "unknown session %q", req.GetSessionId())
+	}
+	return &depute.ResumeContent_Response{
+		Session: s,
+	}, nil
+}
+
+// chunkSession stores the multihashes received on a NotifyContent stream as a
+// chain of entries chunks, recording the progress of the upload session after
+// each chunk so that an interrupted upload can be resumed.
+func (d *Depute) chunkSession(ctx context.Context, ls *ipld.LinkSystem, mhs *notifyContentIter) (ipld.Link, error) {
+	id := mhs.options.GetSessionId()
+	if d.sessionChunkSize == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "resumable uploads are only supported with chained entries")
+	}
+	if !d.acquireSession(id) {
+		return nil, status.Errorf(codes.Aborted, "session %q is in use", id)
+	}
+	defer d.releaseSession(id)
+
+	s, err := d.getContentSession(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get content session: %w", err)
+	}
+	var next ipld.Link
+	switch {
+	case s == nil:
+		if mhs.options.GetOffset() != 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "unknown session %q cannot be resumed at offset %d", id, mhs.options.GetOffset())
+		}
+		if err := d.expireContentSessions(ctx); err != nil {
+			logger.Warnw("Failed to expire content sessions", "err", err)
+		}
+		s = &depute.ContentSession{
+			Id:      id,
+			Options: mhs.options,
+		}
+		if err := d.commitContentSession(ctx, s, nil); err != nil {
+			return nil, fmt.Errorf("failed to store content session: %w", err)
+		}
+		logger.Infow("Started content session", "id", id)
+	case s.Complete:
+		return nil, status.Errorf(codes.FailedPrecondition, "session %q is complete", id)
+	default:
+		if mhs.options.GetOffset() != s.Offset {
+			return nil, status.Errorf(codes.FailedPrecondition, "session %q resumes at offset %d; got %d", id, s.Offset, mhs.options.GetOffset())
+		}
+		if s.Link != nil {
+			if next, err = s.Link.Unmarshal(); err != nil {
+				return nil, fmt.Errorf("invalid content session link: %w", err)
+			}
+		}
+		mhs.options = s.Options
+		mhs.received.Store(s.Offset)
+		mhs.skipped.Store(s.Skipped)
+		mhs.duplicates.Store(s.Duplicates)
+		logger.Infow("Resumed content session", "id", id, "offset", s.Offset)
+	}
+	if s.Options.GetDeduplicate() {
+		// The multihashes seen are only stored along with the chunks that
+		// contain them, so that the set matches the offset of the session.
+		mhs.seen = &mhSet{
+			ds:         d.ds,
+			prefix:     sessionDedupPrefix(id),
+			persistent: true,
+			mem:        make(map[string]struct{}),
+			spilled:    s.Offset != 0,
+		}
+	}
+
+	entries := make([]multihash.Multihash, 0, d.sessionChunkSize)
+	store := func() error {
+		node, err := schema.EntryChunk{Entries: entries, Next: next}.ToNode()
+		if err != nil {
+			return err
+		}
+		next, err = ls.Store(ipld.LinkContext{Ctx: ctx}, schema.Linkproto, node)
+		if err != nil {
+			return err
+		}
+		entries = entries[:0]
+		var l depute.Link
+		if err := l.Marshal(next); err != nil {
+			return err
+		}
+		s.Link = &l
+		s.Offset = mhs.received.Load()
+		s.Skipped = mhs.skipped.Load()
+		s.Duplicates = mhs.duplicates.Load()
+		return d.commitContentSession(ctx, s, mhs.seen)
+	}
+	for {
+		mh, err := mhs.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, mh)
+		if len(entries) >= d.sessionChunkSize {
+			if err := store(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(entries) != 0 {
+		if err := store(); err != nil {
+			return nil, err
+		}
+	}
+
+	s.Complete = true
+	s.Offset = mhs.received.Load()
+	s.Skipped = mhs.skipped.Load()
+	s.Duplicates = mhs.duplicates.Load()
+	if err := d.commitContentSession(ctx, s, nil); err != nil {
+		return nil, err
+	}
+	if mhs.seen != nil {
+		if err := deletePrefix(ctx, d.ds, mhs.seen.prefix); err != nil {
+			logger.Warnw("Failed to release content session multihash set", "id", id, "err", err)
+		}
+	}
+	logger.Infow("Completed content session", "id", id, "count", s.Offset)
+	return next, nil
+}
+
+// getContentSession returns the state of the given upload session, or nil if
+// there is no such session.
+func (d *Depute) getContentSession(ctx context.Context, id string) (*depute.ContentSession, error) {
+	v, err := d.ds.Get(ctx, sessionKey(id))
+	switch err {
+	case nil:
+		var s depute.ContentSession
+		if err := proto.Unmarshal(v, &s); err != nil {
+			return nil, fmt.Errorf("cannot decode content session: %w", err)
+		}
+		return &s, nil
+	case datastore.ErrNotFound:
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
+// commitContentSession stores the state of the given upload session, along
+// with the multihashes seen by it so far if de-duplicating.
+func (d *Depute) commitContentSession(ctx context.Context, s *depute.ContentSession, seen *mhSet) error {
+	s.Updated = time.Now().Unix()
+	v, err := proto.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("cannot encode content session: %w", err)
+	}
+	b, err := d.ds.Batch(ctx)
+	if err != nil {
+		return err
+	}
+	if seen != nil {
+		if err := seen.writeMem(ctx, b); err != nil {
+			return err
+		}
+	}
+	if err := b.Put(ctx, sessionKey(s.Id), v); err != nil {
+		return err
+	}
+	if err := b.Commit(ctx); err != nil {
+		return err
+	}
+	if seen != nil {
+		seen.memSpilled()
+	}
+	return nil
+}
+
+// expireContentSessions removes the upload sessions that have not been
+// updated within the session expiry and are not in use.
+func (d *Depute) expireContentSessions(ctx context.Context) error {
+	results, err := d.ds.Query(ctx, query.Query{
+		Prefix: dsKeyPrefixSession.String(),
+	})
+	if err != nil {
+		return err
+	}
+	defer results.Close()
+	cutoff := time.Now().Add(-d.sessionExpiry).Unix()
+	for r := range results.Next() {
+		if r.Error != nil {
+			return r.Error
+		}
+		var s depute.ContentSession
+		if err := proto.Unmarshal(r.Value, &s); err != nil {
+			return fmt.Errorf("cannot decode content session: %w", err)
+		}
+		if s.Updated >= cutoff || !d.acquireSession(s.Id) {
+			continue
+		}
+		err := d.ds.Delete(ctx, datastore.RawKey(r.Key))
+		if err == nil {
+			err = deletePrefix(ctx, d.ds, sessionDedupPrefix(s.Id))
+		}
+		d.releaseSession(s.Id)
+		if err != nil {
+			return err
+		}
+		logger.Infow("Expired content session", "id", s.Id, "complete", s.Complete)
+	}
+	return nil
+}
+
+// acquireSession marks the given session as in use, and returns false if it
+// is already in use.
+func (d *Depute) acquireSession(id string) bool {
+	d.sessionsLock.Lock()
+	defer d.sessionsLock.Unlock()
+	if _, ok := d.activeSessions[id]; ok {
+		return false
+	}
+	d.activeSessions[id] = struct{}{}
+	return true
+}
+
+func (d *Depute) releaseSession(id string) {
+	d.sessionsLock.Lock()
+	defer d.sessionsLock.Unlock()
+	delete(d.activeSessions, id)
+}
@@ -0,0 +1,86 @@
+package depute
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gogo/status"
+	"github.com/ipld/go-ipld-prime"
+	depute "github.com/ipni/depute/api/v0"
+	"github.com/multiformats/go-multihash"
+	"google.golang.org/grpc/codes"
+)
+
+// chunkSource stores the multihashes of the given source as entries, and
+// returns the link to them.
+func chunkSource(d *Depute, source *testSource) (ipld.Link, error) {
+	mhs, err := d.startNotifyContent(source)
+	if err != nil {
+		return nil, err
+	}
+	defer mhs.close()
+	ls, untrack := d.trackEntries(*d.ls)
+	defer untrack()
+	return d.chunkContent(source.ctx, &ls, mhs)
+}
+
+func TestResumeContentSession(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDepute(t, WithEntriesChunkSize(2))
+	mhs := testMultihashes(t, 9)
+	options := func(offset uint64) *depute.NotifyContent_Options {
+		return &depute.NotifyContent_Options{SessionId: "fish", Offset: offset}
+	}
+
+	// The upload is interrupted after 5 multihashes, of which the first 4 are
+	// stored in complete chunks.
+	_, err := chunkSource(d, &testSource{ctx: ctx, options: options(0), mhs: mhs[:5], err: errors.New("connection lost")})
+	if err == nil {
+		t.Fatal("expected interrupted upload to fail")
+	}
+	resp, err := d.ResumeContent(ctx, &depute.ResumeContent_Request{SessionId: "fish"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := resp.GetSession(); s.GetOffset() != 4 || s.GetComplete() {
+		t.Fatalf("expected incomplete session at offset 4; got %v", s)
+	}
+
+	if _, err := chunkSource(d, &testSource{ctx: ctx, options: options(5), mhs: mhs[5:]}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for a wrong offset; got %v", err)
+	}
+	link, err := chunkSource(d, &testSource{ctx: ctx, options: options(4), mhs: mhs[4:]})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The entries hold every multihash exactly once.
+	want := make(map[string]struct{}, len(mhs))
+	for _, mh := range mhs {
+		want[string(mh)] = struct{}{}
+	}
+	if err := d.walkEntries(ctx, link, func(mh multihash.Multihash) error {
+		if _, ok := want[string(mh)]; !ok {
+			t.Errorf("unexpected or repeated multihash %s", mh.B58String())
+		}
+		delete(want, string(mh))
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(want) != 0 {
+		t.Fatalf("%d multihashes missing from entries", len(want))
+	}
+
+	resp, err = d.ResumeContent(ctx, &depute.ResumeContent_Request{SessionId: "fish"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := resp.GetSession(); s.GetOffset() != uint64(len(mhs)) || !s.GetComplete() {
+		t.Fatalf("expected complete session at offset %d; got %v", len(mhs), s)
+	}
+	if _, err := chunkSource(d, &testSource{ctx: ctx, options: options(uint64(len(mhs)))}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for a complete session; got %v", err)
+	}
+}
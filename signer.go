@@ -0,0 +1,19 @@
+package depute
+
+import (
+	"github.com/ipni/go-libipni/ingest/schema"
+	"github.com/libp2p/go-libp2p/core/crypto"
+)
+
+// Signer signs an advertisement before it is stored and published. The
+// signature must be produced by the key that corresponds to the advertisement
+// provider ID, otherwise indexers will reject the advertisement.
+type Signer func(ad *schema.Advertisement) error
+
+// PrivKeySigner returns a Signer that signs advertisements with the given
+// private key.
+func PrivKeySigner(key crypto.PrivKey) Signer {
+	return func(ad *schema.Advertisement) error {
+		return ad.Sign(key)
+	}
+}
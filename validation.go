@@ -0,0 +1,91 @@
+package depute
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gogo/googleapis/google/rpc"
+	"github.com/gogo/status"
+	depute "github.com/ipni/depute/api/v0"
+	"github.com/ipni/go-libipni/ingest/schema"
+	"github.com/ipni/go-libipni/metadata"
+	"google.golang.org/grpc/codes"
+)
+
+// validateAdvertisement checks the given advertisement against the rules
+// applied by indexers on ingest, and returns the violations found. The field
+// names of violations are prefixed with the given field path.
+//
+// Rules that depend on depute state, such as whether the context ID of a
+// removal has been advertised, are checked separately.
+func validateAdvertisement(field string, ad *depute.Advertisement) []*rpc.BadRequest_FieldViolation {
+	var violations []*rpc.BadRequest_FieldViolation
+	violate := func(name, format string, args ...any) {
+		violations = append(violations, &rpc.BadRequest_FieldViolation{
+			Field:       fieldPath(field, name),
+			Description: fmt.Sprintf(format, args...),
+		})
+	}
+
+	switch l := len(ad.GetContextId()); {
+	case l == 0:
+		violate("context_id", "context ID is required")
+	case l > schema.MaxContextIDLen:
+		violate("context_id", "context ID is %d bytes long, exceeding maximum of %d", l, schema.MaxContextIDLen)
+	}
+
+	mdField := "metadata"
+	if ad.GetTypedMetadata() != nil {
+		mdField = "typed_metadata"
+	}
+	md, err := advertisementMetadata(ad)
+	switch l := len(md); {
+	case err != nil:
+		violate(mdField, "%v", err)
+	case l > schema.MaxMetadataLen:
+		violate(mdField, "metadata is %d bytes long, exceeding maximum of %d", l, schema.MaxMetadataLen)
+	case l == 0:
+		if !ad.GetRemoved() {
+			violate(mdField, "metadata is required unless the advertisement is a removal")
+		}
+	case !ad.GetRemoved() && ad.GetTypedMetadata() == nil:
+		// Typed metadata is encoded by depute, so only raw metadata is
+		// checked for decodability.
+		decoded := metadata.Default.New()
+		if err := decoded.UnmarshalBinary(md); err != nil {
+			violate(mdField, "metadata cannot be decoded: %v", err)
+		}
+	}
+
+	if ad.GetEntries() != nil {
+		if _, err := ad.GetEntries().Unmarshal(); err != nil {
+			violate("entries", "invalid entries link: %v", err)
+		}
+	}
+	return violations
+}
+
+// invalidArgument returns an InvalidArgument status error that carries the
+// given violations as google.rpc.BadRequest details.
+func invalidArgument(violations []*rpc.BadRequest_FieldViolation) error {
+	descs := make([]string, 0, len(violations))
+	for _, v := range violations {
+		descs = append(descs, v.Field+": "+v.Description)
+	}
+	st := status.New(codes.InvalidArgument, strings.Join(descs, "; "))
+	detailed, err := st.WithDetails(&rpc.BadRequest{FieldViolations: violations})
+	if err != nil {
+		logger.Errorw("Failed to attach bad request details", "err", err)
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// fieldPath returns the path of the given field within the given parent
+// field, which is empty for the fields of a request.
+func fieldPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}